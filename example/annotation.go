@@ -2,14 +2,149 @@
 
 package example
 
-// @marshal:true @sql:false @prefix:"My"
+// @marshal:true @sql:false @prefix:"My" @xml @values @names @mustparse @iter @ordinal @exhaustive @flag @list @jsonschema @ordefault @gomap @validate:"oneof" @batch @random @predicates @parsebytes @contains @strictmarshal @jsonv2 @ptrhelper @openapi @slog @csv @appendjson
 // ENUM(pending, running, completed, failed)
 type AnnotationStatus string
 
-// @noprefix @nocase
+// @noprefix @nocase @sql @parsebytes @marshal @appendjson
 // ENUM(annotation_red, annotation_green, annotation_blue)
 type AnnotationColor string
 
-// @marshal @sql @marshal
+// @marshal @sql @marshal @xml @iter @exhaustive @list @cbor @msgpack @gomap @jsonstring @strictmarshal @jsonv2 @appendjson
 // ENUM(one, two, three)
 type AnnotationNumber int
+
+// @yaml @nocase @binary @msgpack @format
+// ENUM(debug, info, warn, error)
+type AnnotationLevel string
+
+// @marshal @values @names @alias:"canceled=cancelled,stopped=cancelled"
+// ENUM(active, cancelled)
+type AnnotationOrder string
+
+// @toml @graphql
+// ENUM(development, staging, production)
+type AnnotationEnvironment string
+
+// @values @hidedeprecated
+// ENUM(
+// active
+// legacy [deprecated] // replaced by active
+// archived
+// )
+type AnnotationAccount int
+
+// AnnotationStage parses case insensitively while keeping its mixed case names
+// @nocase @sql
+// ENUM(InProgress, OnHold, Done)
+type AnnotationStage int
+
+// AnnotationPlan is stored in a nullable text column
+// @sqlnullstr
+// ENUM(free, pro, enterprise)
+type AnnotationPlan string
+
+// AnnotationTier is stored in a nullable integer column
+// @sqlnullint
+// ENUM(bronze, silver, gold)
+type AnnotationTier int
+
+// AnnotationRank is stored as its number rather than its name
+// @sqlint
+// ENUM(one=1, two, three)
+type AnnotationRank int
+
+// AnnotationChannel constants carry a suffix so they don't collide with other identifiers
+// @suffix:"Enum" @marshal @ptr @cbor
+// ENUM(email, sms)
+type AnnotationChannel string
+
+// AnnotationSignal constants carry a suffix even without the type name prefix
+// @suffix:"Signal" @noprefix
+// ENUM(stop, go)
+type AnnotationSignal int
+
+// AnnotationCode is sent over the wire as its number
+// @marshalnumeric @binary @jsonschema @validate:"oneof" @openapi @appendjson
+// ENUM(ok=1, retry, fatal=9)
+type AnnotationCode int
+
+// AnnotationTicket falls back to its default value for empty input and NULL columns
+// @zero @sql @omitzero
+// ENUM(unknown [default], open, closed)
+type AnnotationTicket string
+
+// AnnotationUrgency starts at one, but treats the zero value as valid
+// @zero @zerovalid @omitzero
+// ENUM(low=1, normal [default], high)
+type AnnotationUrgency int
+
+// AnnotationUser describes its values inline
+// @descriptions
+// ENUM(guest, active // user is active, banned // user is banned)
+type AnnotationUser int
+
+// AnnotationPaint drops the redundant prefix of its values
+// @trimprefix:"color_"
+// ENUM(color_red, color_green, color_light_blue)
+type AnnotationPaint string
+
+// AnnotationShade drops the redundant prefix of its values
+// @trimprefix:"shade_"
+// ENUM(shade_dark, shade_light)
+type AnnotationShade int
+
+// AnnotationJob is received either by name or by number
+// @marshal @lenientjson @format @random
+// ENUM(pending, running, done)
+type AnnotationJob int
+
+// AnnotationPhase derives kebab-case strings from its names
+// @case:"kebab"
+// ENUM(InProgress, OnHold, Done)
+type AnnotationPhase int
+
+// AnnotationRegion builds its parse maps the first time it is parsed
+// @lazyparse @nocase
+// ENUM(NorthAmerica, Europe, AsiaPacific)
+type AnnotationRegion int
+
+// AnnotationFruit replaces the type name with its own prefix
+// @noprefix @prefix:"Fruit"
+// ENUM(apple, pear)
+type AnnotationFruit int
+
+// AnnotationCurrency asserts at compile time that it implements the interfaces of its annotations
+// @strict @marshal @xml @binary @sql @flag @format
+// ENUM(usd, eur, brl)
+type AnnotationCurrency string
+
+// AnnotationWeight asserts at compile time that it implements the interfaces of its annotations
+// @strict @marshal @binary @sqlint @flag
+// ENUM(light, heavy)
+type AnnotationWeight int
+
+// ShipmentCarrier shares the Shop namespace with ShipmentSpeed
+// @group:"Shop"
+// ENUM(post, courier)
+type ShipmentCarrier int
+
+// ShipmentSpeed shares the Shop namespace with ShipmentCarrier
+// @group:"Shop"
+// ENUM(standard, express)
+type ShipmentSpeed string
+
+// AnnotationSize words its parse errors in French
+// @errfmt:"%q n'est pas une valeur de %s" @parsebytes
+// ENUM(small, medium, large)
+type AnnotationSize int
+
+// AnnotationState holds the values of AnnotationStatus, IsValid looks them up in a set instead of parsing
+// @setlookup @comment:"Lifecycle states of a job" @trimspace @marshal
+// ENUM(pending, running, completed, failed)
+type AnnotationState string
+
+// AnnotationPriority trims the input of Parse, IsValid still wants a declared value
+// @trimspace
+// ENUM(low, high)
+type AnnotationPriority string
@@ -10,12 +10,362 @@
 package example
 
 import (
+	"bytes"
+	"cmp"
+	"database/sql"
 	"database/sql/driver"
+	"encoding"
+	"encoding/binary"
+	json "encoding/json"
+	"encoding/xml"
 	"errors"
+	"flag"
 	"fmt"
+	"io"
+	"iter"
+	"log/slog"
+	"maps"
+	"math"
+	"math/rand/v2"
+	"strconv"
 	"strings"
+	"sync"
 )
 
+const (
+	// AnnotationAccountActive is a AnnotationAccount of type Active.
+	AnnotationAccountActive AnnotationAccount = iota
+	// AnnotationAccountLegacy is a AnnotationAccount of type Legacy.
+	// replaced by active
+	//
+	// Deprecated: AnnotationAccountLegacy is deprecated.
+	AnnotationAccountLegacy
+	// AnnotationAccountArchived is a AnnotationAccount of type Archived.
+	AnnotationAccountArchived
+)
+
+var ErrInvalidAnnotationAccount = errors.New("not a valid AnnotationAccount")
+
+const _AnnotationAccountName = "activelegacyarchived"
+
+// AnnotationAccountValues returns a list of the values for AnnotationAccount
+func AnnotationAccountValues() []AnnotationAccount {
+	return []AnnotationAccount{
+		AnnotationAccountActive,
+		AnnotationAccountArchived,
+	}
+}
+
+var _AnnotationAccountMap = map[AnnotationAccount]string{
+	AnnotationAccountActive:   _AnnotationAccountName[0:6],
+	AnnotationAccountLegacy:   _AnnotationAccountName[6:12],
+	AnnotationAccountArchived: _AnnotationAccountName[12:20],
+}
+
+// String implements the Stringer interface.
+func (x AnnotationAccount) String() string {
+	if str, ok := _AnnotationAccountMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("AnnotationAccount(%d)", x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x AnnotationAccount) IsValid() bool {
+	_, ok := _AnnotationAccountMap[x]
+	return ok
+}
+
+var _AnnotationAccountValue = map[string]AnnotationAccount{
+	_AnnotationAccountName[0:6]:   AnnotationAccountActive,
+	_AnnotationAccountName[6:12]:  AnnotationAccountLegacy,
+	_AnnotationAccountName[12:20]: AnnotationAccountArchived,
+}
+
+// ParseAnnotationAccount attempts to convert a string to a AnnotationAccount.
+func ParseAnnotationAccount(name string) (AnnotationAccount, error) {
+	if x, ok := _AnnotationAccountValue[name]; ok {
+		return x, nil
+	}
+	return AnnotationAccount(0), fmt.Errorf("%s is %w", name, ErrInvalidAnnotationAccount)
+}
+
+const (
+	// AnnotationChannelEmailEnum is a AnnotationChannel of type email.
+	AnnotationChannelEmailEnum AnnotationChannel = "email"
+	// AnnotationChannelSmsEnum is a AnnotationChannel of type sms.
+	AnnotationChannelSmsEnum AnnotationChannel = "sms"
+)
+
+var ErrInvalidAnnotationChannel = errors.New("not a valid AnnotationChannel")
+
+// String implements the Stringer interface.
+func (x AnnotationChannel) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x AnnotationChannel) IsValid() bool {
+	_, err := ParseAnnotationChannel(string(x))
+	return err == nil
+}
+
+var _AnnotationChannelValue = map[string]AnnotationChannel{
+	"email": AnnotationChannelEmailEnum,
+	"sms":   AnnotationChannelSmsEnum,
+}
+
+// ParseAnnotationChannel attempts to convert a string to a AnnotationChannel.
+func ParseAnnotationChannel(name string) (AnnotationChannel, error) {
+	if x, ok := _AnnotationChannelValue[name]; ok {
+		return x, nil
+	}
+	return AnnotationChannel(""), fmt.Errorf("%s is %w", name, ErrInvalidAnnotationChannel)
+}
+
+// Ptr returns a pointer to a copy of x, for use in optional fields.
+func (x AnnotationChannel) Ptr() *AnnotationChannel {
+	return &x
+}
+
+// PtrString returns the name of the value x points to, or "" when x is nil, so optional fields print without a nil check.
+func (x *AnnotationChannel) PtrString() string {
+	if x == nil {
+		return ""
+	}
+	return x.String()
+}
+
+// MarshalText implements the text marshaller method.
+func (x AnnotationChannel) MarshalText() ([]byte, error) {
+	return x.AppendText(nil)
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *AnnotationChannel) UnmarshalText(text []byte) error {
+	tmp, err := ParseAnnotationChannel(string(text))
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// AppendText appends the textual representation of itself to the end of b
+// (allocating a larger slice if necessary) and returns the updated slice.
+//
+// Implementations must not retain b, nor mutate any bytes within b[:len(b)].
+func (x AnnotationChannel) AppendText(b []byte) ([]byte, error) {
+	return append(b, x.String()...), nil
+}
+
+// MarshalCBOR implements the cbor.Marshaler interface, encoding the value as a CBOR text string.
+func (x AnnotationChannel) MarshalCBOR() ([]byte, error) {
+	return append(_AnnotationChannelAppendCBORHead(nil, 3, uint64(len(x))), x...), nil
+}
+
+// UnmarshalCBOR implements the cbor.Unmarshaler interface.
+func (x *AnnotationChannel) UnmarshalCBOR(data []byte) error {
+	major, arg, rest, err := _AnnotationChannelReadCBORHead(data)
+	if err != nil {
+		return fmt.Errorf("invalid CBOR data for AnnotationChannel: %w", err)
+	}
+	if major != 3 || arg != uint64(len(rest)) {
+		return fmt.Errorf("invalid CBOR data for AnnotationChannel")
+	}
+	tmp, err := ParseAnnotationChannel(string(rest))
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// _AnnotationChannelAppendCBORHead appends the head of a CBOR data item with the given major type and argument.
+func _AnnotationChannelAppendCBORHead(b []byte, major byte, arg uint64) []byte {
+	major <<= 5
+	switch {
+	case arg < 24:
+		return append(b, major|byte(arg))
+	case arg <= math.MaxUint8:
+		return append(b, major|24, byte(arg))
+	case arg <= math.MaxUint16:
+		return binary.BigEndian.AppendUint16(append(b, major|25), uint16(arg))
+	case arg <= math.MaxUint32:
+		return binary.BigEndian.AppendUint32(append(b, major|26), uint32(arg))
+	}
+	return binary.BigEndian.AppendUint64(append(b, major|27), arg)
+}
+
+// _AnnotationChannelReadCBORHead reads the head of a CBOR data item, returning its major type, argument and the remaining data.
+func _AnnotationChannelReadCBORHead(data []byte) (major byte, arg uint64, rest []byte, err error) {
+	if len(data) == 0 {
+		return 0, 0, nil, io.ErrUnexpectedEOF
+	}
+	major, info := data[0]>>5, data[0]&0x1f
+	data = data[1:]
+	if info < 24 {
+		return major, uint64(info), data, nil
+	}
+	if info > 27 {
+		return 0, 0, nil, fmt.Errorf("unsupported CBOR additional information %d", info)
+	}
+	size := 1 << (info - 24)
+	if len(data) < size {
+		return 0, 0, nil, io.ErrUnexpectedEOF
+	}
+	for _, b := range data[:size] {
+		arg = arg<<8 | uint64(b)
+	}
+	return major, arg, data[size:], nil
+}
+
+const (
+	// AnnotationCodeOk is a AnnotationCode of type Ok.
+	AnnotationCodeOk AnnotationCode = iota + 1
+	// AnnotationCodeRetry is a AnnotationCode of type Retry.
+	AnnotationCodeRetry
+	// AnnotationCodeFatal is a AnnotationCode of type Fatal.
+	AnnotationCodeFatal AnnotationCode = iota + 7
+)
+
+var ErrInvalidAnnotationCode = errors.New("not a valid AnnotationCode")
+
+const _AnnotationCodeName = "okretryfatal"
+
+var _AnnotationCodeMap = map[AnnotationCode]string{
+	AnnotationCodeOk:    _AnnotationCodeName[0:2],
+	AnnotationCodeRetry: _AnnotationCodeName[2:7],
+	AnnotationCodeFatal: _AnnotationCodeName[7:12],
+}
+
+// String implements the Stringer interface.
+func (x AnnotationCode) String() string {
+	if str, ok := _AnnotationCodeMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("AnnotationCode(%d)", x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x AnnotationCode) IsValid() bool {
+	_, ok := _AnnotationCodeMap[x]
+	return ok
+}
+
+var _AnnotationCodeValue = map[string]AnnotationCode{
+	_AnnotationCodeName[0:2]:  AnnotationCodeOk,
+	_AnnotationCodeName[2:7]:  AnnotationCodeRetry,
+	_AnnotationCodeName[7:12]: AnnotationCodeFatal,
+}
+
+// ParseAnnotationCode attempts to convert a string to a AnnotationCode.
+func ParseAnnotationCode(name string) (AnnotationCode, error) {
+	if x, ok := _AnnotationCodeValue[name]; ok {
+		return x, nil
+	}
+	return AnnotationCode(0), fmt.Errorf("%s is %w", name, ErrInvalidAnnotationCode)
+}
+
+// AnnotationCodeOpenAPISchema returns an OpenAPI schema fragment describing the allowed values of AnnotationCode,
+// with the names of their constants in the same order as x-enum-varnames.
+func AnnotationCodeOpenAPISchema() map[string]interface{} {
+	return map[string]interface{}{
+		"type": "integer",
+		"enum": []interface{}{
+			1,
+			2,
+			9,
+		},
+		"x-enum-varnames": []interface{}{
+			"AnnotationCodeOk",
+			"AnnotationCodeRetry",
+			"AnnotationCodeFatal",
+		},
+	}
+}
+
+// AppendJSON appends the JSON of x to b, the same as json.Marshal writing its number,
+// and returns the updated slice.
+func (x AnnotationCode) AppendJSON(b []byte) ([]byte, error) {
+	return strconv.AppendInt(b, int64(x), 10), nil
+}
+
+// MarshalJSON implements the json.Marshaler interface, writing the number of the value.
+func (x AnnotationCode) MarshalJSON() ([]byte, error) {
+	return json.Marshal(int64(x))
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, accepting the number of a value.
+func (x *AnnotationCode) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var v int64
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	tmp := AnnotationCode(v)
+	if int64(tmp) != v || !tmp.IsValid() {
+		return fmt.Errorf("%d is %w", v, ErrInvalidAnnotationCode)
+	}
+	*x = tmp
+	return nil
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface, encoding the value as a varint.
+func (x AnnotationCode) MarshalBinary() ([]byte, error) {
+	return x.AppendBinary(nil)
+}
+
+// AppendBinary implements the encoding.BinaryAppender interface.
+func (x AnnotationCode) AppendBinary(b []byte) ([]byte, error) {
+	return binary.AppendVarint(b, int64(x)), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+func (x *AnnotationCode) UnmarshalBinary(data []byte) error {
+	v, n := binary.Varint(data)
+	if n <= 0 || n != len(data) {
+		return fmt.Errorf("invalid binary data for AnnotationCode")
+	}
+	tmp := AnnotationCode(v)
+	if int64(tmp) != v || !tmp.IsValid() {
+		return fmt.Errorf("%d is %w", v, ErrInvalidAnnotationCode)
+	}
+	*x = tmp
+	return nil
+}
+
+// Validate returns an error wrapping ErrInvalidAnnotationCode when x is not a valid AnnotationCode.
+func (x AnnotationCode) Validate() error {
+	if !x.IsValid() {
+		return fmt.Errorf("%d is %w", x, ErrInvalidAnnotationCode)
+	}
+	return nil
+}
+
+// AnnotationCodeOneOf returns the valid values of AnnotationCode separated by spaces, for the oneof rule of
+// go-playground/validator: `validate:"oneof=1 2 9"`.
+func AnnotationCodeOneOf() string {
+	return "1 2 9"
+}
+
+// AnnotationCodeJSONSchema returns a JSON schema fragment describing the allowed values of AnnotationCode.
+func AnnotationCodeJSONSchema() map[string]interface{} {
+	return map[string]interface{}{
+		"type": "integer",
+		"enum": []interface{}{
+			1,
+			2,
+			9,
+		},
+	}
+}
+
 const (
 	// AnnotationRed is a AnnotationColor of type annotation_red.
 	AnnotationRed AnnotationColor = "annotation_red"
@@ -25,95 +375,3264 @@ const (
 	AnnotationBlue AnnotationColor = "annotation_blue"
 )
 
-var ErrInvalidAnnotationColor = errors.New("not a valid AnnotationColor")
+var ErrInvalidAnnotationColor = errors.New("not a valid AnnotationColor")
+
+// String implements the Stringer interface.
+func (x AnnotationColor) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x AnnotationColor) IsValid() bool {
+	_, err := ParseAnnotationColor(string(x))
+	return err == nil
+}
+
+var _AnnotationColorValue = map[string]AnnotationColor{
+	"annotation_red":   AnnotationRed,
+	"annotation_green": AnnotationGreen,
+	"annotation_blue":  AnnotationBlue,
+}
+
+var _AnnotationColorLowerValue = map[string]AnnotationColor{
+	"annotation_red":   AnnotationRed,
+	"annotation_green": AnnotationGreen,
+	"annotation_blue":  AnnotationBlue,
+}
+
+// ParseAnnotationColor attempts to convert a string to a AnnotationColor.
+func ParseAnnotationColor(name string) (AnnotationColor, error) {
+	if x, ok := _AnnotationColorValue[name]; ok {
+		return x, nil
+	}
+	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of lowercasing a string if we don't need to.
+	// Inputs too long to lowercase into a name are not normalized at all.
+	if len(name) <= 64 {
+		if x, ok := _AnnotationColorLowerValue[strings.ToLower(name)]; ok {
+			return x, nil
+		}
+	}
+	return AnnotationColor(""), fmt.Errorf("%s is %w", name, ErrInvalidAnnotationColor)
+}
+
+// AppendJSON appends the JSON of x to b, the same as json.Marshal writing its quoted name,
+// and returns the updated slice.
+func (x AnnotationColor) AppendJSON(b []byte) ([]byte, error) {
+	if !x.IsValid() {
+		v, err := json.Marshal(string(x))
+		return append(b, v...), err
+	}
+	b = append(b, '"')
+	b = append(b, x.String()...)
+	return append(b, '"'), nil
+}
+
+// ParseAnnotationColorBytes converts a byte slice to a AnnotationColor like ParseAnnotationColor,
+// looking it up without converting it to a string first so parsing a valid name doesn't allocate.
+func ParseAnnotationColorBytes(b []byte) (AnnotationColor, error) {
+	if x, ok := _AnnotationColorValue[string(b)]; ok {
+		return x, nil
+	}
+	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of lowercasing a string if we don't need to.
+	// Inputs too long to lowercase into a name are not normalized at all.
+	if len(b) <= 64 {
+		if x, ok := _AnnotationColorLowerValue[string(bytes.ToLower(b))]; ok {
+			return x, nil
+		}
+	}
+	return AnnotationColor(""), fmt.Errorf("%s is %w", b, ErrInvalidAnnotationColor)
+}
+
+// MarshalText implements the text marshaller method.
+func (x AnnotationColor) MarshalText() ([]byte, error) {
+	return x.AppendText(nil)
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *AnnotationColor) UnmarshalText(text []byte) error {
+	tmp, err := ParseAnnotationColor(string(text))
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// AppendText appends the textual representation of itself to the end of b
+// (allocating a larger slice if necessary) and returns the updated slice.
+//
+// Implementations must not retain b, nor mutate any bytes within b[:len(b)].
+func (x AnnotationColor) AppendText(b []byte) ([]byte, error) {
+	return append(b, x.String()...), nil
+}
+
+var errAnnotationColorNilPtr = errors.New("value pointer is nil") // one per type for package clashes
+
+// Scan implements the Scanner interface.
+func (x *AnnotationColor) Scan(value interface{}) (err error) {
+	if value == nil {
+		*x = AnnotationColor("")
+		return
+	}
+
+	// sql.RawBytes is a distinct type, treat it like any other []byte.
+	if raw, ok := value.(sql.RawBytes); ok {
+		value = []byte(raw)
+	}
+
+	// A wider range of scannable types.
+	// driver.Value values at the top of the list for expediency
+	switch v := value.(type) {
+	case string:
+		*x, err = ParseAnnotationColor(v)
+	case []byte:
+		*x, err = ParseAnnotationColor(string(v))
+	case AnnotationColor:
+		// An already typed value is assigned as is, once it is known to be declared
+		if !v.IsValid() {
+			return fmt.Errorf("%s is %w", string(v), ErrInvalidAnnotationColor)
+		}
+		*x = v
+	case *AnnotationColor:
+		if v == nil {
+			return errAnnotationColorNilPtr
+		}
+		if !v.IsValid() {
+			return fmt.Errorf("%s is %w", string(*v), ErrInvalidAnnotationColor)
+		}
+		*x = *v
+	case *string:
+		if v == nil {
+			return errAnnotationColorNilPtr
+		}
+		*x, err = ParseAnnotationColor(*v)
+	default:
+		return fmt.Errorf("invalid type %T for AnnotationColor", value)
+	}
+
+	return
+}
+
+// Value implements the driver Valuer interface.
+func (x AnnotationColor) Value() (driver.Value, error) {
+	return x.String(), nil
+}
+
+const (
+	// AnnotationCurrencyUsd is a AnnotationCurrency of type usd.
+	AnnotationCurrencyUsd AnnotationCurrency = "usd"
+	// AnnotationCurrencyEur is a AnnotationCurrency of type eur.
+	AnnotationCurrencyEur AnnotationCurrency = "eur"
+	// AnnotationCurrencyBrl is a AnnotationCurrency of type brl.
+	AnnotationCurrencyBrl AnnotationCurrency = "brl"
+)
+
+var ErrInvalidAnnotationCurrency = errors.New("not a valid AnnotationCurrency")
+
+// String implements the Stringer interface.
+func (x AnnotationCurrency) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x AnnotationCurrency) IsValid() bool {
+	_, err := ParseAnnotationCurrency(string(x))
+	return err == nil
+}
+
+var _AnnotationCurrencyValue = map[string]AnnotationCurrency{
+	"usd": AnnotationCurrencyUsd,
+	"eur": AnnotationCurrencyEur,
+	"brl": AnnotationCurrencyBrl,
+}
+
+// ParseAnnotationCurrency attempts to convert a string to a AnnotationCurrency.
+func ParseAnnotationCurrency(name string) (AnnotationCurrency, error) {
+	if x, ok := _AnnotationCurrencyValue[name]; ok {
+		return x, nil
+	}
+	return AnnotationCurrency(""), fmt.Errorf("%s is %w", name, ErrInvalidAnnotationCurrency)
+}
+
+// MarshalText implements the text marshaller method.
+func (x AnnotationCurrency) MarshalText() ([]byte, error) {
+	return x.AppendText(nil)
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *AnnotationCurrency) UnmarshalText(text []byte) error {
+	tmp, err := ParseAnnotationCurrency(string(text))
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// AppendText appends the textual representation of itself to the end of b
+// (allocating a larger slice if necessary) and returns the updated slice.
+//
+// Implementations must not retain b, nor mutate any bytes within b[:len(b)].
+func (x AnnotationCurrency) AppendText(b []byte) ([]byte, error) {
+	return append(b, x.String()...), nil
+}
+
+// MarshalXML implements the xml.Marshaler interface.
+func (x AnnotationCurrency) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return e.EncodeElement(x.String(), start)
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface.
+func (x *AnnotationCurrency) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var name string
+	if err := d.DecodeElement(&name, &start); err != nil {
+		return err
+	}
+	tmp, err := ParseAnnotationCurrency(name)
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// MarshalXMLAttr implements the xml.MarshalerAttr interface.
+func (x AnnotationCurrency) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return xml.Attr{Name: name, Value: x.String()}, nil
+}
+
+// UnmarshalXMLAttr implements the xml.UnmarshalerAttr interface.
+func (x *AnnotationCurrency) UnmarshalXMLAttr(attr xml.Attr) error {
+	tmp, err := ParseAnnotationCurrency(attr.Value)
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+func (x AnnotationCurrency) MarshalBinary() ([]byte, error) {
+	return []byte(x), nil
+}
+
+// AppendBinary implements the encoding.BinaryAppender interface.
+func (x AnnotationCurrency) AppendBinary(b []byte) ([]byte, error) {
+	return append(b, x...), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+func (x *AnnotationCurrency) UnmarshalBinary(data []byte) error {
+	tmp, err := ParseAnnotationCurrency(string(data))
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// Format implements fmt.Formatter, so %v, %s and %q print the string value of x,
+// while %d prints its 0-based declaration position, or -1 if x is not a declared value.
+func (x AnnotationCurrency) Format(f fmt.State, verb rune) {
+	switch verb {
+	case 'd':
+		ordinal := -1
+		switch x {
+		case AnnotationCurrencyUsd:
+			ordinal = 0
+		case AnnotationCurrencyEur:
+			ordinal = 1
+		case AnnotationCurrencyBrl:
+			ordinal = 2
+		}
+		fmt.Fprintf(f, fmt.FormatString(f, verb), ordinal)
+	default:
+		fmt.Fprintf(f, fmt.FormatString(f, verb), x.String())
+	}
+}
+
+// Compile time assertions that AnnotationCurrency implements the interfaces of its generated methods,
+// so dropping an annotation or a hand-written method that other code relies on fails to build.
+var (
+	_ fmt.Stringer               = (*AnnotationCurrency)(nil)
+	_ encoding.TextMarshaler     = (*AnnotationCurrency)(nil)
+	_ encoding.TextUnmarshaler   = (*AnnotationCurrency)(nil)
+	_ encoding.TextAppender      = (*AnnotationCurrency)(nil)
+	_ xml.Marshaler              = (*AnnotationCurrency)(nil)
+	_ xml.Unmarshaler            = (*AnnotationCurrency)(nil)
+	_ xml.MarshalerAttr          = (*AnnotationCurrency)(nil)
+	_ xml.UnmarshalerAttr        = (*AnnotationCurrency)(nil)
+	_ encoding.BinaryMarshaler   = (*AnnotationCurrency)(nil)
+	_ encoding.BinaryUnmarshaler = (*AnnotationCurrency)(nil)
+	_ encoding.BinaryAppender    = (*AnnotationCurrency)(nil)
+	_ sql.Scanner                = (*AnnotationCurrency)(nil)
+	_ driver.Valuer              = (*AnnotationCurrency)(nil)
+	_ flag.Value                 = (*AnnotationCurrency)(nil)
+	_ fmt.Formatter              = (*AnnotationCurrency)(nil)
+)
+
+var errAnnotationCurrencyNilPtr = errors.New("value pointer is nil") // one per type for package clashes
+
+// Scan implements the Scanner interface.
+func (x *AnnotationCurrency) Scan(value interface{}) (err error) {
+	if value == nil {
+		*x = AnnotationCurrency("")
+		return
+	}
+
+	// sql.RawBytes is a distinct type, treat it like any other []byte.
+	if raw, ok := value.(sql.RawBytes); ok {
+		value = []byte(raw)
+	}
+
+	// A wider range of scannable types.
+	// driver.Value values at the top of the list for expediency
+	switch v := value.(type) {
+	case string:
+		*x, err = ParseAnnotationCurrency(v)
+	case []byte:
+		*x, err = ParseAnnotationCurrency(string(v))
+	case AnnotationCurrency:
+		// An already typed value is assigned as is, once it is known to be declared
+		if !v.IsValid() {
+			return fmt.Errorf("%s is %w", string(v), ErrInvalidAnnotationCurrency)
+		}
+		*x = v
+	case *AnnotationCurrency:
+		if v == nil {
+			return errAnnotationCurrencyNilPtr
+		}
+		if !v.IsValid() {
+			return fmt.Errorf("%s is %w", string(*v), ErrInvalidAnnotationCurrency)
+		}
+		*x = *v
+	case *string:
+		if v == nil {
+			return errAnnotationCurrencyNilPtr
+		}
+		*x, err = ParseAnnotationCurrency(*v)
+	default:
+		return fmt.Errorf("invalid type %T for AnnotationCurrency", value)
+	}
+
+	return
+}
+
+// Value implements the driver Valuer interface.
+func (x AnnotationCurrency) Value() (driver.Value, error) {
+	return x.String(), nil
+}
+
+// Set implements the Golang flag.Value interface func.
+func (x *AnnotationCurrency) Set(val string) error {
+	v, err := ParseAnnotationCurrency(val)
+	*x = v
+	return err
+}
+
+// Get implements the Golang flag.Getter interface func.
+func (x *AnnotationCurrency) Get() interface{} {
+	return *x
+}
+
+// Type implements the github.com/spf13/pFlag Value interface.
+func (x *AnnotationCurrency) Type() string {
+	return "AnnotationCurrency"
+}
+
+const (
+	// AnnotationEnvironmentDevelopment is a AnnotationEnvironment of type development.
+	AnnotationEnvironmentDevelopment AnnotationEnvironment = "development"
+	// AnnotationEnvironmentStaging is a AnnotationEnvironment of type staging.
+	AnnotationEnvironmentStaging AnnotationEnvironment = "staging"
+	// AnnotationEnvironmentProduction is a AnnotationEnvironment of type production.
+	AnnotationEnvironmentProduction AnnotationEnvironment = "production"
+)
+
+var ErrInvalidAnnotationEnvironment = errors.New("not a valid AnnotationEnvironment")
+
+// String implements the Stringer interface.
+func (x AnnotationEnvironment) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x AnnotationEnvironment) IsValid() bool {
+	_, err := ParseAnnotationEnvironment(string(x))
+	return err == nil
+}
+
+var _AnnotationEnvironmentValue = map[string]AnnotationEnvironment{
+	"development": AnnotationEnvironmentDevelopment,
+	"staging":     AnnotationEnvironmentStaging,
+	"production":  AnnotationEnvironmentProduction,
+}
+
+// ParseAnnotationEnvironment attempts to convert a string to a AnnotationEnvironment.
+func ParseAnnotationEnvironment(name string) (AnnotationEnvironment, error) {
+	if x, ok := _AnnotationEnvironmentValue[name]; ok {
+		return x, nil
+	}
+	return AnnotationEnvironment(""), fmt.Errorf("%s is %w", name, ErrInvalidAnnotationEnvironment)
+}
+
+// MarshalTOML implements the toml.Marshaler interface.
+func (x AnnotationEnvironment) MarshalTOML() ([]byte, error) {
+	return []byte(strconv.Quote(x.String())), nil
+}
+
+// UnmarshalTOML implements the toml.Unmarshaler interface.
+func (x *AnnotationEnvironment) UnmarshalTOML(v interface{}) error {
+	name, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("invalid type %T for AnnotationEnvironment, expected a string", v)
+	}
+	tmp, err := ParseAnnotationEnvironment(name)
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// MarshalGQL implements the graphql.Marshaler interface of gqlgen.
+func (x AnnotationEnvironment) MarshalGQL(w io.Writer) {
+	_, _ = io.WriteString(w, strconv.Quote(x.String()))
+}
+
+// UnmarshalGQL implements the graphql.Unmarshaler interface of gqlgen.
+func (x *AnnotationEnvironment) UnmarshalGQL(v interface{}) error {
+	name, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("invalid type %T for AnnotationEnvironment, expected a string", v)
+	}
+	tmp, err := ParseAnnotationEnvironment(name)
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+const (
+	// FruitApple is a AnnotationFruit of type Apple.
+	FruitApple AnnotationFruit = iota
+	// FruitPear is a AnnotationFruit of type Pear.
+	FruitPear
+)
+
+var ErrInvalidAnnotationFruit = errors.New("not a valid AnnotationFruit")
+
+const _AnnotationFruitName = "applepear"
+
+var _AnnotationFruitMap = map[AnnotationFruit]string{
+	FruitApple: _AnnotationFruitName[0:5],
+	FruitPear:  _AnnotationFruitName[5:9],
+}
+
+// String implements the Stringer interface.
+func (x AnnotationFruit) String() string {
+	if str, ok := _AnnotationFruitMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("AnnotationFruit(%d)", x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x AnnotationFruit) IsValid() bool {
+	_, ok := _AnnotationFruitMap[x]
+	return ok
+}
+
+var _AnnotationFruitValue = map[string]AnnotationFruit{
+	_AnnotationFruitName[0:5]: FruitApple,
+	_AnnotationFruitName[5:9]: FruitPear,
+}
+
+// ParseAnnotationFruit attempts to convert a string to a AnnotationFruit.
+func ParseAnnotationFruit(name string) (AnnotationFruit, error) {
+	if x, ok := _AnnotationFruitValue[name]; ok {
+		return x, nil
+	}
+	return AnnotationFruit(0), fmt.Errorf("%s is %w", name, ErrInvalidAnnotationFruit)
+}
+
+const (
+	// AnnotationJobPending is a AnnotationJob of type Pending.
+	AnnotationJobPending AnnotationJob = iota
+	// AnnotationJobRunning is a AnnotationJob of type Running.
+	AnnotationJobRunning
+	// AnnotationJobDone is a AnnotationJob of type Done.
+	AnnotationJobDone
+)
+
+var ErrInvalidAnnotationJob = errors.New("not a valid AnnotationJob")
+
+const _AnnotationJobName = "pendingrunningdone"
+
+var _AnnotationJobMap = map[AnnotationJob]string{
+	AnnotationJobPending: _AnnotationJobName[0:7],
+	AnnotationJobRunning: _AnnotationJobName[7:14],
+	AnnotationJobDone:    _AnnotationJobName[14:18],
+}
+
+// String implements the Stringer interface.
+func (x AnnotationJob) String() string {
+	if str, ok := _AnnotationJobMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("AnnotationJob(%d)", x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x AnnotationJob) IsValid() bool {
+	_, ok := _AnnotationJobMap[x]
+	return ok
+}
+
+var _AnnotationJobValue = map[string]AnnotationJob{
+	_AnnotationJobName[0:7]:   AnnotationJobPending,
+	_AnnotationJobName[7:14]:  AnnotationJobRunning,
+	_AnnotationJobName[14:18]: AnnotationJobDone,
+}
+
+// ParseAnnotationJob attempts to convert a string to a AnnotationJob.
+func ParseAnnotationJob(name string) (AnnotationJob, error) {
+	if x, ok := _AnnotationJobValue[name]; ok {
+		return x, nil
+	}
+	return AnnotationJob(0), fmt.Errorf("%s is %w", name, ErrInvalidAnnotationJob)
+}
+
+// MarshalText implements the text marshaller method.
+func (x AnnotationJob) MarshalText() ([]byte, error) {
+	return x.AppendText(nil)
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *AnnotationJob) UnmarshalText(text []byte) error {
+	name := string(text)
+	tmp, err := ParseAnnotationJob(name)
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// AppendText appends the textual representation of itself to the end of b
+// (allocating a larger slice if necessary) and returns the updated slice.
+//
+// Implementations must not retain b, nor mutate any bytes within b[:len(b)].
+func (x AnnotationJob) AppendText(b []byte) ([]byte, error) {
+	return append(b, x.String()...), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, accepting either the name or the number of a value.
+func (x *AnnotationJob) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var name string
+	if err := json.Unmarshal(b, &name); err == nil {
+		tmp, err := ParseAnnotationJob(name)
+		if err != nil {
+			return err
+		}
+		*x = tmp
+		return nil
+	}
+	var v int64
+	if err := json.Unmarshal(b, &v); err != nil {
+		return fmt.Errorf("%s is %w", b, ErrInvalidAnnotationJob)
+	}
+	tmp := AnnotationJob(v)
+	if int64(tmp) != v || !tmp.IsValid() {
+		return fmt.Errorf("%d is %w", v, ErrInvalidAnnotationJob)
+	}
+	*x = tmp
+	return nil
+}
+
+// Format implements fmt.Formatter, so %v and %s print the name of x, even inside slices and structs,
+// while %d and the other integer verbs print its number.
+func (x AnnotationJob) Format(f fmt.State, verb rune) {
+	switch verb {
+	case 'v', 's', 'q':
+		fmt.Fprintf(f, fmt.FormatString(f, verb), x.String())
+	default:
+		fmt.Fprintf(f, fmt.FormatString(f, verb), int(x))
+	}
+}
+
+// RandomAnnotationJob returns a uniformly random declared AnnotationJob, drawn from r.
+func RandomAnnotationJob(r *rand.Rand) AnnotationJob {
+	return _AnnotationJobRandom[r.IntN(len(_AnnotationJobRandom))]
+}
+
+// RandomAnnotationJobGlobal returns a uniformly random declared AnnotationJob, drawn from the global source.
+func RandomAnnotationJobGlobal() AnnotationJob {
+	return _AnnotationJobRandom[rand.IntN(len(_AnnotationJobRandom))]
+}
+
+var _AnnotationJobRandom = []AnnotationJob{
+	AnnotationJobPending,
+	AnnotationJobRunning,
+	AnnotationJobDone,
+}
+
+const (
+	// AnnotationLevelDebug is a AnnotationLevel of type debug.
+	AnnotationLevelDebug AnnotationLevel = "debug"
+	// AnnotationLevelInfo is a AnnotationLevel of type info.
+	AnnotationLevelInfo AnnotationLevel = "info"
+	// AnnotationLevelWarn is a AnnotationLevel of type warn.
+	AnnotationLevelWarn AnnotationLevel = "warn"
+	// AnnotationLevelError is a AnnotationLevel of type error.
+	AnnotationLevelError AnnotationLevel = "error"
+)
+
+var ErrInvalidAnnotationLevel = errors.New("not a valid AnnotationLevel")
+
+// String implements the Stringer interface.
+func (x AnnotationLevel) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x AnnotationLevel) IsValid() bool {
+	_, err := ParseAnnotationLevel(string(x))
+	return err == nil
+}
+
+var _AnnotationLevelValue = map[string]AnnotationLevel{
+	"debug": AnnotationLevelDebug,
+	"info":  AnnotationLevelInfo,
+	"warn":  AnnotationLevelWarn,
+	"error": AnnotationLevelError,
+}
+
+var _AnnotationLevelLowerValue = map[string]AnnotationLevel{
+	"debug": AnnotationLevelDebug,
+	"info":  AnnotationLevelInfo,
+	"warn":  AnnotationLevelWarn,
+	"error": AnnotationLevelError,
+}
+
+// ParseAnnotationLevel attempts to convert a string to a AnnotationLevel.
+func ParseAnnotationLevel(name string) (AnnotationLevel, error) {
+	if x, ok := _AnnotationLevelValue[name]; ok {
+		return x, nil
+	}
+	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of lowercasing a string if we don't need to.
+	// Inputs too long to lowercase into a name are not normalized at all.
+	if len(name) <= 20 {
+		if x, ok := _AnnotationLevelLowerValue[strings.ToLower(name)]; ok {
+			return x, nil
+		}
+	}
+	return AnnotationLevel(""), fmt.Errorf("%s is %w", name, ErrInvalidAnnotationLevel)
+}
+
+// MarshalYAML implements the yaml.Marshaler interface.
+func (x AnnotationLevel) MarshalYAML() (interface{}, error) {
+	return x.String(), nil
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface.
+func (x *AnnotationLevel) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var name string
+	if err := unmarshal(&name); err != nil {
+		return err
+	}
+	tmp, err := ParseAnnotationLevel(name)
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+func (x AnnotationLevel) MarshalBinary() ([]byte, error) {
+	return []byte(x), nil
+}
+
+// AppendBinary implements the encoding.BinaryAppender interface.
+func (x AnnotationLevel) AppendBinary(b []byte) ([]byte, error) {
+	return append(b, x...), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+func (x *AnnotationLevel) UnmarshalBinary(data []byte) error {
+	tmp, err := ParseAnnotationLevel(string(data))
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// MarshalMsgpack implements the msgpack.Marshaler interface, encoding the value as a msgpack string.
+func (x AnnotationLevel) MarshalMsgpack() ([]byte, error) {
+	var b []byte
+	switch n := len(x); {
+	case n < 32:
+		b = []byte{0xa0 | byte(n)}
+	case n <= math.MaxUint8:
+		b = []byte{0xd9, byte(n)}
+	case n <= math.MaxUint16:
+		b = binary.BigEndian.AppendUint16([]byte{0xda}, uint16(n))
+	default:
+		b = binary.BigEndian.AppendUint32([]byte{0xdb}, uint32(n))
+	}
+	return append(b, x...), nil
+}
+
+// UnmarshalMsgpack implements the msgpack.Unmarshaler interface.
+func (x *AnnotationLevel) UnmarshalMsgpack(data []byte) error {
+	if len(data) == 0 {
+		return fmt.Errorf("invalid msgpack data for AnnotationLevel: %w", io.ErrUnexpectedEOF)
+	}
+	var n, size int
+	switch b := data[0]; {
+	case b&0xe0 == 0xa0: // fixstr
+		n = int(b & 0x1f)
+	case b >= 0xd9 && b <= 0xdb: // str 8-32
+		size = 1 << (b - 0xd9)
+	default:
+		return fmt.Errorf("invalid msgpack data for AnnotationLevel")
+	}
+	if len(data) < 1+size {
+		return fmt.Errorf("invalid msgpack data for AnnotationLevel: %w", io.ErrUnexpectedEOF)
+	}
+	for _, c := range data[1 : 1+size] {
+		n = n<<8 | int(c)
+	}
+	if len(data) != 1+size+n {
+		return fmt.Errorf("invalid msgpack data for AnnotationLevel")
+	}
+	tmp, err := ParseAnnotationLevel(string(data[1+size:]))
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// Format implements fmt.Formatter, so %v, %s and %q print the string value of x,
+// while %d prints its 0-based declaration position, or -1 if x is not a declared value.
+func (x AnnotationLevel) Format(f fmt.State, verb rune) {
+	switch verb {
+	case 'd':
+		ordinal := -1
+		switch x {
+		case AnnotationLevelDebug:
+			ordinal = 0
+		case AnnotationLevelInfo:
+			ordinal = 1
+		case AnnotationLevelWarn:
+			ordinal = 2
+		case AnnotationLevelError:
+			ordinal = 3
+		}
+		fmt.Fprintf(f, fmt.FormatString(f, verb), ordinal)
+	default:
+		fmt.Fprintf(f, fmt.FormatString(f, verb), x.String())
+	}
+}
+
+const (
+	// AnnotationNumberOne is a AnnotationNumber of type One.
+	AnnotationNumberOne AnnotationNumber = iota
+	// AnnotationNumberTwo is a AnnotationNumber of type Two.
+	AnnotationNumberTwo
+	// AnnotationNumberThree is a AnnotationNumber of type Three.
+	AnnotationNumberThree
+)
+
+var ErrInvalidAnnotationNumber = errors.New("not a valid AnnotationNumber")
+
+const _AnnotationNumberName = "onetwothree"
+
+var _AnnotationNumberMap = map[AnnotationNumber]string{
+	AnnotationNumberOne:   _AnnotationNumberName[0:3],
+	AnnotationNumberTwo:   _AnnotationNumberName[3:6],
+	AnnotationNumberThree: _AnnotationNumberName[6:11],
+}
+
+// String implements the Stringer interface.
+func (x AnnotationNumber) String() string {
+	if str, ok := _AnnotationNumberMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("AnnotationNumber(%d)", x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x AnnotationNumber) IsValid() bool {
+	_, ok := _AnnotationNumberMap[x]
+	return ok
+}
+
+var _AnnotationNumberValue = map[string]AnnotationNumber{
+	_AnnotationNumberName[0:3]:  AnnotationNumberOne,
+	_AnnotationNumberName[3:6]:  AnnotationNumberTwo,
+	_AnnotationNumberName[6:11]: AnnotationNumberThree,
+}
+
+// ParseAnnotationNumber attempts to convert a string to a AnnotationNumber.
+func ParseAnnotationNumber(name string) (AnnotationNumber, error) {
+	if x, ok := _AnnotationNumberValue[name]; ok {
+		return x, nil
+	}
+	return AnnotationNumber(0), fmt.Errorf("%s is %w", name, ErrInvalidAnnotationNumber)
+}
+
+// AppendJSON appends the JSON of x to b, the same as json.Marshal writing its quoted name,
+// and returns the updated slice.
+func (x AnnotationNumber) AppendJSON(b []byte) ([]byte, error) {
+	if !x.IsValid() {
+		return nil, fmt.Errorf("%d is %w", x, ErrInvalidAnnotationNumber)
+	}
+	b = append(b, '"')
+	b = append(b, x.String()...)
+	return append(b, '"'), nil
+}
+
+// MarshalText implements the text marshaller method.
+func (x AnnotationNumber) MarshalText() ([]byte, error) {
+	return x.AppendText(nil)
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *AnnotationNumber) UnmarshalText(text []byte) error {
+	name := string(text)
+	tmp, err := ParseAnnotationNumber(name)
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// AppendText appends the textual representation of itself to the end of b
+// (allocating a larger slice if necessary) and returns the updated slice.
+//
+// Implementations must not retain b, nor mutate any bytes within b[:len(b)].
+func (x AnnotationNumber) AppendText(b []byte) ([]byte, error) {
+	if !x.IsValid() {
+		return nil, fmt.Errorf("%d is %w", x, ErrInvalidAnnotationNumber)
+	}
+	return append(b, x.String()...), nil
+}
+
+// MarshalXML implements the xml.Marshaler interface.
+func (x AnnotationNumber) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return e.EncodeElement(x.String(), start)
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface.
+func (x *AnnotationNumber) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var name string
+	if err := d.DecodeElement(&name, &start); err != nil {
+		return err
+	}
+	tmp, err := ParseAnnotationNumber(name)
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// MarshalXMLAttr implements the xml.MarshalerAttr interface.
+func (x AnnotationNumber) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return xml.Attr{Name: name, Value: x.String()}, nil
+}
+
+// UnmarshalXMLAttr implements the xml.UnmarshalerAttr interface.
+func (x *AnnotationNumber) UnmarshalXMLAttr(attr xml.Attr) error {
+	tmp, err := ParseAnnotationNumber(attr.Value)
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// AnnotationNumberAll returns an iterator over the values of AnnotationNumber in declaration order.
+func AnnotationNumberAll() iter.Seq[AnnotationNumber] {
+	return func(yield func(AnnotationNumber) bool) {
+		for _, x := range []AnnotationNumber{
+			AnnotationNumberOne,
+			AnnotationNumberTwo,
+			AnnotationNumberThree,
+		} {
+			if !yield(x) {
+				return
+			}
+		}
+	}
+}
+
+// MarshalJSON implements the json.Marshaler interface, writing the name of the value.
+func (x AnnotationNumber) MarshalJSON() ([]byte, error) {
+	if !x.IsValid() {
+		return nil, fmt.Errorf("%d is %w", x, ErrInvalidAnnotationNumber)
+	}
+	return json.Marshal(x.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, accepting the name of a value.
+func (x *AnnotationNumber) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var name string
+	if err := json.Unmarshal(b, &name); err != nil {
+		return fmt.Errorf("%s is %w", b, ErrInvalidAnnotationNumber)
+	}
+	tmp, err := ParseAnnotationNumber(name)
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// _AnnotationNumberExhaustive references every value of AnnotationNumber in a switch, so removing a
+// value breaks the build and the exhaustive linter knows the complete list of cases.
+func _AnnotationNumberExhaustive(x AnnotationNumber) {
+	switch x {
+	case AnnotationNumberOne:
+	case AnnotationNumberTwo:
+	case AnnotationNumberThree:
+	}
+}
+
+var _ = _AnnotationNumberExhaustive
+
+// MarshalCBOR implements the cbor.Marshaler interface, encoding the value as a CBOR integer.
+func (x AnnotationNumber) MarshalCBOR() ([]byte, error) {
+	if x < 0 {
+		return _AnnotationNumberAppendCBORHead(nil, 1, uint64(-1-int64(x))), nil
+	}
+	return _AnnotationNumberAppendCBORHead(nil, 0, uint64(x)), nil
+}
+
+// UnmarshalCBOR implements the cbor.Unmarshaler interface.
+func (x *AnnotationNumber) UnmarshalCBOR(data []byte) error {
+	major, arg, rest, err := _AnnotationNumberReadCBORHead(data)
+	if err != nil {
+		return fmt.Errorf("invalid CBOR data for AnnotationNumber: %w", err)
+	}
+	if len(rest) != 0 || major > 1 || arg > math.MaxInt64 {
+		return fmt.Errorf("invalid CBOR data for AnnotationNumber")
+	}
+	v := int64(arg)
+	if major == 1 {
+		v = -1 - v
+	}
+	tmp := AnnotationNumber(v)
+	if int64(tmp) != v || !tmp.IsValid() {
+		return fmt.Errorf("%d is %w", v, ErrInvalidAnnotationNumber)
+	}
+	*x = tmp
+	return nil
+}
+
+// _AnnotationNumberAppendCBORHead appends the head of a CBOR data item with the given major type and argument.
+func _AnnotationNumberAppendCBORHead(b []byte, major byte, arg uint64) []byte {
+	major <<= 5
+	switch {
+	case arg < 24:
+		return append(b, major|byte(arg))
+	case arg <= math.MaxUint8:
+		return append(b, major|24, byte(arg))
+	case arg <= math.MaxUint16:
+		return binary.BigEndian.AppendUint16(append(b, major|25), uint16(arg))
+	case arg <= math.MaxUint32:
+		return binary.BigEndian.AppendUint32(append(b, major|26), uint32(arg))
+	}
+	return binary.BigEndian.AppendUint64(append(b, major|27), arg)
+}
+
+// _AnnotationNumberReadCBORHead reads the head of a CBOR data item, returning its major type, argument and the remaining data.
+func _AnnotationNumberReadCBORHead(data []byte) (major byte, arg uint64, rest []byte, err error) {
+	if len(data) == 0 {
+		return 0, 0, nil, io.ErrUnexpectedEOF
+	}
+	major, info := data[0]>>5, data[0]&0x1f
+	data = data[1:]
+	if info < 24 {
+		return major, uint64(info), data, nil
+	}
+	if info > 27 {
+		return 0, 0, nil, fmt.Errorf("unsupported CBOR additional information %d", info)
+	}
+	size := 1 << (info - 24)
+	if len(data) < size {
+		return 0, 0, nil, io.ErrUnexpectedEOF
+	}
+	for _, b := range data[:size] {
+		arg = arg<<8 | uint64(b)
+	}
+	return major, arg, data[size:], nil
+}
+
+// MarshalMsgpack implements the msgpack.Marshaler interface, encoding the value as a msgpack integer.
+func (x AnnotationNumber) MarshalMsgpack() ([]byte, error) {
+	if v := int64(x); v < 0 {
+		switch {
+		case v >= -32:
+			return []byte{byte(v)}, nil
+		case v >= math.MinInt8:
+			return []byte{0xd0, byte(v)}, nil
+		case v >= math.MinInt16:
+			return binary.BigEndian.AppendUint16([]byte{0xd1}, uint16(v)), nil
+		case v >= math.MinInt32:
+			return binary.BigEndian.AppendUint32([]byte{0xd2}, uint32(v)), nil
+		}
+		return binary.BigEndian.AppendUint64([]byte{0xd3}, uint64(v)), nil
+	}
+	switch v := uint64(x); {
+	case v <= math.MaxInt8:
+		return []byte{byte(v)}, nil
+	case v <= math.MaxUint8:
+		return []byte{0xcc, byte(v)}, nil
+	case v <= math.MaxUint16:
+		return binary.BigEndian.AppendUint16([]byte{0xcd}, uint16(v)), nil
+	case v <= math.MaxUint32:
+		return binary.BigEndian.AppendUint32([]byte{0xce}, uint32(v)), nil
+	default:
+		return binary.BigEndian.AppendUint64([]byte{0xcf}, v), nil
+	}
+}
+
+// UnmarshalMsgpack implements the msgpack.Unmarshaler interface.
+func (x *AnnotationNumber) UnmarshalMsgpack(data []byte) error {
+	if len(data) == 0 {
+		return fmt.Errorf("invalid msgpack data for AnnotationNumber: %w", io.ErrUnexpectedEOF)
+	}
+	var v int64
+	switch b := data[0]; {
+	case b <= 0x7f || b >= 0xe0: // positive and negative fixint
+		if len(data) != 1 {
+			return fmt.Errorf("invalid msgpack data for AnnotationNumber")
+		}
+		v = int64(int8(b))
+	case b >= 0xcc && b <= 0xd3: // uint 8-64 and int 8-64
+		size := 1 << ((b - 0xcc) % 4)
+		if len(data) != 1+size {
+			return fmt.Errorf("invalid msgpack data for AnnotationNumber")
+		}
+		var u uint64
+		for _, c := range data[1:] {
+			u = u<<8 | uint64(c)
+		}
+		if b >= 0xd0 {
+			shift := 64 - 8*size
+			v = int64(u<<shift) >> shift
+		} else if u > math.MaxInt64 {
+			return fmt.Errorf("invalid msgpack data for AnnotationNumber")
+		} else {
+			v = int64(u)
+		}
+	default:
+		return fmt.Errorf("invalid msgpack data for AnnotationNumber")
+	}
+	tmp := AnnotationNumber(v)
+	if int64(tmp) != v || !tmp.IsValid() {
+		return fmt.Errorf("%d is %w", v, ErrInvalidAnnotationNumber)
+	}
+	*x = tmp
+	return nil
+}
+
+// AnnotationNumberNameMap returns a copy of the map from the names to the values of AnnotationNumber.
+func AnnotationNumberNameMap() map[string]AnnotationNumber {
+	m := make(map[string]AnnotationNumber, len(_AnnotationNumberMap))
+	for x, name := range _AnnotationNumberMap {
+		m[name] = x
+	}
+	return m
+}
+
+// AnnotationNumberValueMap returns a copy of the map from the values of AnnotationNumber to their names.
+func AnnotationNumberValueMap() map[AnnotationNumber]string {
+	return maps.Clone(_AnnotationNumberMap)
+}
+
+// ParseAnnotationNumberList converts a comma separated list of strings to AnnotationNumber values.
+// An empty string returns an empty list.
+func ParseAnnotationNumberList(s string) ([]AnnotationNumber, error) {
+	list := []AnnotationNumber{}
+	if strings.TrimSpace(s) == "" {
+		return list, nil
+	}
+	for _, name := range strings.Split(s, ",") {
+		x, err := ParseAnnotationNumber(strings.TrimSpace(name))
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, x)
+	}
+	return list, nil
+}
+
+var errAnnotationNumberNilPtr = errors.New("value pointer is nil") // one per type for package clashes
+
+// Scan implements the Scanner interface.
+func (x *AnnotationNumber) Scan(value interface{}) (err error) {
+	if value == nil {
+		*x = AnnotationNumber(0)
+		return
+	}
+
+	// sql.RawBytes is a distinct type, treat it like any other []byte.
+	if raw, ok := value.(sql.RawBytes); ok {
+		value = []byte(raw)
+	}
+
+	// A wider range of scannable types.
+	// driver.Value values at the top of the list for expediency
+	switch v := value.(type) {
+	case int64:
+		*x, err = _AnnotationNumberFromInt64(v)
+	case string:
+		*x, err = ParseAnnotationNumber(v)
+	case []byte:
+		*x, err = ParseAnnotationNumber(string(v))
+	case AnnotationNumber:
+		// An already typed value is assigned as is, once it is known to be declared
+		if !v.IsValid() {
+			return fmt.Errorf("%d is %w", v, ErrInvalidAnnotationNumber)
+		}
+		*x = v
+	case *AnnotationNumber:
+		if v == nil {
+			return errAnnotationNumberNilPtr
+		}
+		if !v.IsValid() {
+			return fmt.Errorf("%d is %w", *v, ErrInvalidAnnotationNumber)
+		}
+		*x = *v
+	case int:
+		*x, err = _AnnotationNumberFromInt64(int64(v))
+	case int8:
+		*x, err = _AnnotationNumberFromInt64(int64(v))
+	case int16:
+		*x, err = _AnnotationNumberFromInt64(int64(v))
+	case int32:
+		*x, err = _AnnotationNumberFromInt64(int64(v))
+	case uint:
+		*x, err = _AnnotationNumberFromUint64(uint64(v))
+	case uint8:
+		*x, err = _AnnotationNumberFromUint64(uint64(v))
+	case uint16:
+		*x, err = _AnnotationNumberFromUint64(uint64(v))
+	case uint32:
+		*x, err = _AnnotationNumberFromUint64(uint64(v))
+	case uint64:
+		*x, err = _AnnotationNumberFromUint64(v)
+	case *int:
+		if v == nil {
+			return errAnnotationNumberNilPtr
+		}
+		*x, err = _AnnotationNumberFromInt64(int64(*v))
+	case *int64:
+		if v == nil {
+			return errAnnotationNumberNilPtr
+		}
+		*x, err = _AnnotationNumberFromInt64(*v)
+	case float64: // json marshals everything as a float64 if it's a number
+		*x, err = _AnnotationNumberFromFloat64(v)
+	case *float64: // json marshals everything as a float64 if it's a number
+		if v == nil {
+			return errAnnotationNumberNilPtr
+		}
+		*x, err = _AnnotationNumberFromFloat64(*v)
+	case *uint:
+		if v == nil {
+			return errAnnotationNumberNilPtr
+		}
+		*x, err = _AnnotationNumberFromUint64(uint64(*v))
+	case *uint64:
+		if v == nil {
+			return errAnnotationNumberNilPtr
+		}
+		*x, err = _AnnotationNumberFromUint64(*v)
+	case *string:
+		if v == nil {
+			return errAnnotationNumberNilPtr
+		}
+		*x, err = ParseAnnotationNumber(*v)
+	default:
+		return fmt.Errorf("invalid type %T for AnnotationNumber", value)
+	}
+
+	return
+}
+
+// _AnnotationNumberFromInt64 converts an integer read from the database, failing when it does not fit in AnnotationNumber
+// or is not one of its values.
+func _AnnotationNumberFromInt64(v int64) (AnnotationNumber, error) {
+	x := AnnotationNumber(v)
+	if int64(x) != v || !x.IsValid() {
+		return AnnotationNumber(0), fmt.Errorf("%d is %w", v, ErrInvalidAnnotationNumber)
+	}
+	return x, nil
+}
+
+// _AnnotationNumberFromUint64 converts an unsigned integer read from the database, like _AnnotationNumberFromInt64.
+func _AnnotationNumberFromUint64(v uint64) (AnnotationNumber, error) {
+	if v > math.MaxInt64 {
+		return AnnotationNumber(0), fmt.Errorf("%d is %w", v, ErrInvalidAnnotationNumber)
+	}
+	return _AnnotationNumberFromInt64(int64(v))
+}
+
+// _AnnotationNumberFromFloat64 converts a number decoded as a float64, like _AnnotationNumberFromInt64, failing when it is not a whole number.
+func _AnnotationNumberFromFloat64(v float64) (AnnotationNumber, error) {
+	if v != math.Trunc(v) || v < math.MinInt64 || v >= math.MaxInt64 {
+		return AnnotationNumber(0), fmt.Errorf("%v is %w", v, ErrInvalidAnnotationNumber)
+	}
+	return _AnnotationNumberFromInt64(int64(v))
+}
+
+// Value implements the driver Valuer interface.
+func (x AnnotationNumber) Value() (driver.Value, error) {
+	return x.String(), nil
+}
+
+const (
+	// AnnotationOrderActive is a AnnotationOrder of type active.
+	AnnotationOrderActive AnnotationOrder = "active"
+	// AnnotationOrderCancelled is a AnnotationOrder of type cancelled.
+	AnnotationOrderCancelled AnnotationOrder = "cancelled"
+)
+
+var ErrInvalidAnnotationOrder = fmt.Errorf("not a valid AnnotationOrder, try [%s]", strings.Join(_AnnotationOrderNames, ", "))
+
+var _AnnotationOrderNames = []string{
+	string(AnnotationOrderActive),
+	string(AnnotationOrderCancelled),
+}
+
+// AnnotationOrderNames returns a list of possible string values of AnnotationOrder.
+func AnnotationOrderNames() []string {
+	tmp := make([]string, len(_AnnotationOrderNames))
+	copy(tmp, _AnnotationOrderNames)
+	return tmp
+}
+
+// AnnotationOrderValues returns a list of the values for AnnotationOrder
+func AnnotationOrderValues() []AnnotationOrder {
+	return []AnnotationOrder{
+		AnnotationOrderActive,
+		AnnotationOrderCancelled,
+	}
+}
+
+// String implements the Stringer interface.
+func (x AnnotationOrder) String() string {
+	return string(x)
+}
+
+// _AnnotationOrderSet holds the declared values of AnnotationOrder for IsValid.
+var _AnnotationOrderSet = map[AnnotationOrder]struct{}{
+	AnnotationOrderActive:    {},
+	AnnotationOrderCancelled: {},
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x AnnotationOrder) IsValid() bool {
+	_, ok := _AnnotationOrderSet[x]
+	return ok
+}
+
+var _AnnotationOrderValue = map[string]AnnotationOrder{
+	"active":    AnnotationOrderActive,
+	"cancelled": AnnotationOrderCancelled,
+	"canceled":  AnnotationOrderCancelled,
+	"stopped":   AnnotationOrderCancelled,
+}
+
+// ParseAnnotationOrder attempts to convert a string to a AnnotationOrder.
+func ParseAnnotationOrder(name string) (AnnotationOrder, error) {
+	if x, ok := _AnnotationOrderValue[name]; ok {
+		return x, nil
+	}
+	return AnnotationOrder(""), fmt.Errorf("%s is %w", name, ErrInvalidAnnotationOrder)
+}
+
+// MarshalText implements the text marshaller method.
+func (x AnnotationOrder) MarshalText() ([]byte, error) {
+	return x.AppendText(nil)
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *AnnotationOrder) UnmarshalText(text []byte) error {
+	tmp, err := ParseAnnotationOrder(string(text))
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// AppendText appends the textual representation of itself to the end of b
+// (allocating a larger slice if necessary) and returns the updated slice.
+//
+// Implementations must not retain b, nor mutate any bytes within b[:len(b)].
+func (x AnnotationOrder) AppendText(b []byte) ([]byte, error) {
+	return append(b, x.String()...), nil
+}
+
+const (
+	// AnnotationPaintRed is a AnnotationPaint of type red.
+	AnnotationPaintRed AnnotationPaint = "red"
+	// AnnotationPaintGreen is a AnnotationPaint of type green.
+	AnnotationPaintGreen AnnotationPaint = "green"
+	// AnnotationPaintLightBlue is a AnnotationPaint of type light_blue.
+	AnnotationPaintLightBlue AnnotationPaint = "light_blue"
+)
+
+var ErrInvalidAnnotationPaint = errors.New("not a valid AnnotationPaint")
+
+// String implements the Stringer interface.
+func (x AnnotationPaint) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x AnnotationPaint) IsValid() bool {
+	_, err := ParseAnnotationPaint(string(x))
+	return err == nil
+}
+
+var _AnnotationPaintValue = map[string]AnnotationPaint{
+	"red":        AnnotationPaintRed,
+	"green":      AnnotationPaintGreen,
+	"light_blue": AnnotationPaintLightBlue,
+}
+
+// ParseAnnotationPaint attempts to convert a string to a AnnotationPaint.
+func ParseAnnotationPaint(name string) (AnnotationPaint, error) {
+	if x, ok := _AnnotationPaintValue[name]; ok {
+		return x, nil
+	}
+	return AnnotationPaint(""), fmt.Errorf("%s is %w", name, ErrInvalidAnnotationPaint)
+}
+
+const (
+	// AnnotationPhaseInProgress is a AnnotationPhase of type InProgress.
+	AnnotationPhaseInProgress AnnotationPhase = iota
+	// AnnotationPhaseOnHold is a AnnotationPhase of type OnHold.
+	AnnotationPhaseOnHold
+	// AnnotationPhaseDone is a AnnotationPhase of type Done.
+	AnnotationPhaseDone
+)
+
+var ErrInvalidAnnotationPhase = errors.New("not a valid AnnotationPhase")
+
+const _AnnotationPhaseName = "in-progresson-holddone"
+
+var _AnnotationPhaseMap = map[AnnotationPhase]string{
+	AnnotationPhaseInProgress: _AnnotationPhaseName[0:11],
+	AnnotationPhaseOnHold:     _AnnotationPhaseName[11:18],
+	AnnotationPhaseDone:       _AnnotationPhaseName[18:22],
+}
+
+// String implements the Stringer interface.
+func (x AnnotationPhase) String() string {
+	if str, ok := _AnnotationPhaseMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("AnnotationPhase(%d)", x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x AnnotationPhase) IsValid() bool {
+	_, ok := _AnnotationPhaseMap[x]
+	return ok
+}
+
+var _AnnotationPhaseValue = map[string]AnnotationPhase{
+	_AnnotationPhaseName[0:11]:  AnnotationPhaseInProgress,
+	_AnnotationPhaseName[11:18]: AnnotationPhaseOnHold,
+	_AnnotationPhaseName[18:22]: AnnotationPhaseDone,
+}
+
+// ParseAnnotationPhase attempts to convert a string to a AnnotationPhase.
+func ParseAnnotationPhase(name string) (AnnotationPhase, error) {
+	if x, ok := _AnnotationPhaseValue[name]; ok {
+		return x, nil
+	}
+	return AnnotationPhase(0), fmt.Errorf("%s is %w", name, ErrInvalidAnnotationPhase)
+}
+
+const (
+	// AnnotationPlanFree is a AnnotationPlan of type free.
+	AnnotationPlanFree AnnotationPlan = "free"
+	// AnnotationPlanPro is a AnnotationPlan of type pro.
+	AnnotationPlanPro AnnotationPlan = "pro"
+	// AnnotationPlanEnterprise is a AnnotationPlan of type enterprise.
+	AnnotationPlanEnterprise AnnotationPlan = "enterprise"
+)
+
+var ErrInvalidAnnotationPlan = errors.New("not a valid AnnotationPlan")
+
+// String implements the Stringer interface.
+func (x AnnotationPlan) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x AnnotationPlan) IsValid() bool {
+	_, err := ParseAnnotationPlan(string(x))
+	return err == nil
+}
+
+var _AnnotationPlanValue = map[string]AnnotationPlan{
+	"free":       AnnotationPlanFree,
+	"pro":        AnnotationPlanPro,
+	"enterprise": AnnotationPlanEnterprise,
+}
+
+// ParseAnnotationPlan attempts to convert a string to a AnnotationPlan.
+func ParseAnnotationPlan(name string) (AnnotationPlan, error) {
+	if x, ok := _AnnotationPlanValue[name]; ok {
+		return x, nil
+	}
+	return AnnotationPlan(""), fmt.Errorf("%s is %w", name, ErrInvalidAnnotationPlan)
+}
+
+var errAnnotationPlanNilPtr = errors.New("value pointer is nil") // one per type for package clashes
+
+// Scan implements the Scanner interface.
+func (x *AnnotationPlan) Scan(value interface{}) (err error) {
+	if value == nil {
+		*x = AnnotationPlan("")
+		return
+	}
+
+	// sql.RawBytes is a distinct type, treat it like any other []byte.
+	if raw, ok := value.(sql.RawBytes); ok {
+		value = []byte(raw)
+	}
+
+	// A wider range of scannable types.
+	// driver.Value values at the top of the list for expediency
+	switch v := value.(type) {
+	case string:
+		*x, err = ParseAnnotationPlan(v)
+	case []byte:
+		*x, err = ParseAnnotationPlan(string(v))
+	case AnnotationPlan:
+		// An already typed value is assigned as is, once it is known to be declared
+		if !v.IsValid() {
+			return fmt.Errorf("%s is %w", string(v), ErrInvalidAnnotationPlan)
+		}
+		*x = v
+	case *AnnotationPlan:
+		if v == nil {
+			return errAnnotationPlanNilPtr
+		}
+		if !v.IsValid() {
+			return fmt.Errorf("%s is %w", string(*v), ErrInvalidAnnotationPlan)
+		}
+		*x = *v
+	case *string:
+		if v == nil {
+			return errAnnotationPlanNilPtr
+		}
+		*x, err = ParseAnnotationPlan(*v)
+	default:
+		return fmt.Errorf("invalid type %T for AnnotationPlan", value)
+	}
+
+	return
+}
+
+// Value implements the driver Valuer interface.
+func (x AnnotationPlan) Value() (driver.Value, error) {
+	return x.String(), nil
+}
+
+type NullAnnotationPlan struct {
+	AnnotationPlan AnnotationPlan
+	Valid          bool
+}
+
+func NewNullAnnotationPlan(val interface{}) (x NullAnnotationPlan) {
+	err := x.Scan(val) // yes, we ignore this error, it will just be an invalid value.
+	_ = err            // make any errcheck linters happy
+	return
+}
+
+// Scan implements the Scanner interface.
+func (x *NullAnnotationPlan) Scan(value interface{}) (err error) {
+	if value == nil {
+		x.AnnotationPlan, x.Valid = AnnotationPlan(""), false
+		return
+	}
+
+	err = x.AnnotationPlan.Scan(value)
+	x.Valid = (err == nil)
+	return
+}
+
+// Value implements the driver Valuer interface.
+func (x NullAnnotationPlan) Value() (driver.Value, error) {
+	if !x.Valid {
+		return nil, nil
+	}
+	return x.AnnotationPlan.String(), nil
+}
+
+const (
+	// AnnotationPriorityLow is a AnnotationPriority of type low.
+	AnnotationPriorityLow AnnotationPriority = "low"
+	// AnnotationPriorityHigh is a AnnotationPriority of type high.
+	AnnotationPriorityHigh AnnotationPriority = "high"
+)
+
+var ErrInvalidAnnotationPriority = errors.New("not a valid AnnotationPriority")
+
+// String implements the Stringer interface.
+func (x AnnotationPriority) String() string {
+	return string(x)
+}
+
+// _AnnotationPrioritySet holds the declared values of AnnotationPriority for IsValid.
+var _AnnotationPrioritySet = map[AnnotationPriority]struct{}{
+	AnnotationPriorityLow:  {},
+	AnnotationPriorityHigh: {},
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x AnnotationPriority) IsValid() bool {
+	_, ok := _AnnotationPrioritySet[x]
+	return ok
+}
+
+var _AnnotationPriorityValue = map[string]AnnotationPriority{
+	"low":  AnnotationPriorityLow,
+	"high": AnnotationPriorityHigh,
+}
+
+// ParseAnnotationPriority attempts to convert a string to a AnnotationPriority.
+func ParseAnnotationPriority(name string) (AnnotationPriority, error) {
+	name = strings.TrimSpace(name)
+	if x, ok := _AnnotationPriorityValue[name]; ok {
+		return x, nil
+	}
+	return AnnotationPriority(""), fmt.Errorf("%s is %w", name, ErrInvalidAnnotationPriority)
+}
+
+const (
+	// AnnotationRankOne is a AnnotationRank of type One.
+	AnnotationRankOne AnnotationRank = iota + 1
+	// AnnotationRankTwo is a AnnotationRank of type Two.
+	AnnotationRankTwo
+	// AnnotationRankThree is a AnnotationRank of type Three.
+	AnnotationRankThree
+)
+
+var ErrInvalidAnnotationRank = errors.New("not a valid AnnotationRank")
+
+const _AnnotationRankName = "onetwothree"
+
+var _AnnotationRankMap = map[AnnotationRank]string{
+	AnnotationRankOne:   _AnnotationRankName[0:3],
+	AnnotationRankTwo:   _AnnotationRankName[3:6],
+	AnnotationRankThree: _AnnotationRankName[6:11],
+}
+
+// String implements the Stringer interface.
+func (x AnnotationRank) String() string {
+	if str, ok := _AnnotationRankMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("AnnotationRank(%d)", x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x AnnotationRank) IsValid() bool {
+	_, ok := _AnnotationRankMap[x]
+	return ok
+}
+
+var _AnnotationRankValue = map[string]AnnotationRank{
+	_AnnotationRankName[0:3]:  AnnotationRankOne,
+	_AnnotationRankName[3:6]:  AnnotationRankTwo,
+	_AnnotationRankName[6:11]: AnnotationRankThree,
+}
+
+// ParseAnnotationRank attempts to convert a string to a AnnotationRank.
+func ParseAnnotationRank(name string) (AnnotationRank, error) {
+	if x, ok := _AnnotationRankValue[name]; ok {
+		return x, nil
+	}
+	return AnnotationRank(0), fmt.Errorf("%s is %w", name, ErrInvalidAnnotationRank)
+}
+
+var errAnnotationRankNilPtr = errors.New("value pointer is nil") // one per type for package clashes
+
+// Scan implements the Scanner interface.
+func (x *AnnotationRank) Scan(value interface{}) (err error) {
+	if value == nil {
+		*x = AnnotationRank(0)
+		return
+	}
+
+	// sql.RawBytes is a distinct type, treat it like any other []byte.
+	if raw, ok := value.(sql.RawBytes); ok {
+		value = []byte(raw)
+	}
+
+	// A wider range of scannable types.
+	// driver.Value values at the top of the list for expediency
+	switch v := value.(type) {
+	case int64:
+		*x, err = _AnnotationRankFromInt64(v)
+	case string:
+		*x, err = ParseAnnotationRank(v)
+		if err != nil {
+			// try parsing the integer value as a string
+			if val, verr := strconv.Atoi(v); verr == nil {
+				*x, err = _AnnotationRankFromInt64(int64(val))
+			}
+		}
+	case []byte:
+		*x, err = ParseAnnotationRank(string(v))
+		if err != nil {
+			// try parsing the integer value as a string
+			if val, verr := strconv.Atoi(string(v)); verr == nil {
+				*x, err = _AnnotationRankFromInt64(int64(val))
+			}
+		}
+	case AnnotationRank:
+		// An already typed value is assigned as is, once it is known to be declared
+		if !v.IsValid() {
+			return fmt.Errorf("%d is %w", v, ErrInvalidAnnotationRank)
+		}
+		*x = v
+	case *AnnotationRank:
+		if v == nil {
+			return errAnnotationRankNilPtr
+		}
+		if !v.IsValid() {
+			return fmt.Errorf("%d is %w", *v, ErrInvalidAnnotationRank)
+		}
+		*x = *v
+	case int:
+		*x, err = _AnnotationRankFromInt64(int64(v))
+	case int8:
+		*x, err = _AnnotationRankFromInt64(int64(v))
+	case int16:
+		*x, err = _AnnotationRankFromInt64(int64(v))
+	case int32:
+		*x, err = _AnnotationRankFromInt64(int64(v))
+	case uint:
+		*x, err = _AnnotationRankFromUint64(uint64(v))
+	case uint8:
+		*x, err = _AnnotationRankFromUint64(uint64(v))
+	case uint16:
+		*x, err = _AnnotationRankFromUint64(uint64(v))
+	case uint32:
+		*x, err = _AnnotationRankFromUint64(uint64(v))
+	case uint64:
+		*x, err = _AnnotationRankFromUint64(v)
+	case *int:
+		if v == nil {
+			return errAnnotationRankNilPtr
+		}
+		*x, err = _AnnotationRankFromInt64(int64(*v))
+	case *int64:
+		if v == nil {
+			return errAnnotationRankNilPtr
+		}
+		*x, err = _AnnotationRankFromInt64(*v)
+	case float64: // json marshals everything as a float64 if it's a number
+		*x, err = _AnnotationRankFromFloat64(v)
+	case *float64: // json marshals everything as a float64 if it's a number
+		if v == nil {
+			return errAnnotationRankNilPtr
+		}
+		*x, err = _AnnotationRankFromFloat64(*v)
+	case *uint:
+		if v == nil {
+			return errAnnotationRankNilPtr
+		}
+		*x, err = _AnnotationRankFromUint64(uint64(*v))
+	case *uint64:
+		if v == nil {
+			return errAnnotationRankNilPtr
+		}
+		*x, err = _AnnotationRankFromUint64(*v)
+	case *string:
+		if v == nil {
+			return errAnnotationRankNilPtr
+		}
+		*x, err = ParseAnnotationRank(*v)
+		if err != nil {
+			// try parsing the integer value as a string
+			if val, verr := strconv.Atoi(*v); verr == nil {
+				*x, err = _AnnotationRankFromInt64(int64(val))
+			}
+		}
+	default:
+		return fmt.Errorf("invalid type %T for AnnotationRank", value)
+	}
+
+	return
+}
+
+// _AnnotationRankFromInt64 converts an integer read from the database, failing when it does not fit in AnnotationRank
+// or is not one of its values.
+func _AnnotationRankFromInt64(v int64) (AnnotationRank, error) {
+	x := AnnotationRank(v)
+	if int64(x) != v || !x.IsValid() {
+		return AnnotationRank(0), fmt.Errorf("%d is %w", v, ErrInvalidAnnotationRank)
+	}
+	return x, nil
+}
+
+// _AnnotationRankFromUint64 converts an unsigned integer read from the database, like _AnnotationRankFromInt64.
+func _AnnotationRankFromUint64(v uint64) (AnnotationRank, error) {
+	if v > math.MaxInt64 {
+		return AnnotationRank(0), fmt.Errorf("%d is %w", v, ErrInvalidAnnotationRank)
+	}
+	return _AnnotationRankFromInt64(int64(v))
+}
+
+// _AnnotationRankFromFloat64 converts a number decoded as a float64, like _AnnotationRankFromInt64, failing when it is not a whole number.
+func _AnnotationRankFromFloat64(v float64) (AnnotationRank, error) {
+	if v != math.Trunc(v) || v < math.MinInt64 || v >= math.MaxInt64 {
+		return AnnotationRank(0), fmt.Errorf("%v is %w", v, ErrInvalidAnnotationRank)
+	}
+	return _AnnotationRankFromInt64(int64(v))
+}
+
+// Value implements the driver Valuer interface.
+func (x AnnotationRank) Value() (driver.Value, error) {
+	return int64(x), nil
+}
+
+const (
+	// AnnotationRegionNorthAmerica is a AnnotationRegion of type NorthAmerica.
+	AnnotationRegionNorthAmerica AnnotationRegion = iota
+	// AnnotationRegionEurope is a AnnotationRegion of type Europe.
+	AnnotationRegionEurope
+	// AnnotationRegionAsiaPacific is a AnnotationRegion of type AsiaPacific.
+	AnnotationRegionAsiaPacific
+)
+
+var ErrInvalidAnnotationRegion = errors.New("not a valid AnnotationRegion")
+
+const _AnnotationRegionName = "NorthAmericaEuropeAsiaPacific"
+
+var _AnnotationRegionMap = map[AnnotationRegion]string{
+	AnnotationRegionNorthAmerica: _AnnotationRegionName[0:12],
+	AnnotationRegionEurope:       _AnnotationRegionName[12:18],
+	AnnotationRegionAsiaPacific:  _AnnotationRegionName[18:29],
+}
+
+// String implements the Stringer interface.
+func (x AnnotationRegion) String() string {
+	if str, ok := _AnnotationRegionMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("AnnotationRegion(%d)", x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x AnnotationRegion) IsValid() bool {
+	_, ok := _AnnotationRegionMap[x]
+	return ok
+}
+
+var (
+	_AnnotationRegionValue      map[string]AnnotationRegion
+	_AnnotationRegionLowerValue map[string]AnnotationRegion
+	_AnnotationRegionValueOnce  sync.Once
+)
+
+// _AnnotationRegionInitValue builds the lookup maps of AnnotationRegion the first time they are needed.
+func _AnnotationRegionInitValue() {
+	_AnnotationRegionValueOnce.Do(func() {
+		_AnnotationRegionValue = map[string]AnnotationRegion{
+			_AnnotationRegionName[0:12]:  AnnotationRegionNorthAmerica,
+			_AnnotationRegionName[12:18]: AnnotationRegionEurope,
+			_AnnotationRegionName[18:29]: AnnotationRegionAsiaPacific,
+		}
+		_AnnotationRegionLowerValue = map[string]AnnotationRegion{
+			strings.ToLower(_AnnotationRegionName[0:12]):  AnnotationRegionNorthAmerica,
+			strings.ToLower(_AnnotationRegionName[12:18]): AnnotationRegionEurope,
+			strings.ToLower(_AnnotationRegionName[18:29]): AnnotationRegionAsiaPacific,
+		}
+	})
+}
+
+// ParseAnnotationRegion attempts to convert a string to a AnnotationRegion.
+func ParseAnnotationRegion(name string) (AnnotationRegion, error) {
+	_AnnotationRegionInitValue()
+	if x, ok := _AnnotationRegionValue[name]; ok {
+		return x, nil
+	}
+	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of lowercasing a string if we don't need to.
+	// Inputs too long to lowercase into a name are not normalized at all.
+	if len(name) <= 48 {
+		if x, ok := _AnnotationRegionLowerValue[strings.ToLower(name)]; ok {
+			return x, nil
+		}
+	}
+	return AnnotationRegion(0), fmt.Errorf("%s is %w", name, ErrInvalidAnnotationRegion)
+}
+
+const (
+	// AnnotationShadeDark is a AnnotationShade of type Dark.
+	AnnotationShadeDark AnnotationShade = iota
+	// AnnotationShadeLight is a AnnotationShade of type Light.
+	AnnotationShadeLight
+)
+
+var ErrInvalidAnnotationShade = errors.New("not a valid AnnotationShade")
+
+const _AnnotationShadeName = "darklight"
+
+var _AnnotationShadeMap = map[AnnotationShade]string{
+	AnnotationShadeDark:  _AnnotationShadeName[0:4],
+	AnnotationShadeLight: _AnnotationShadeName[4:9],
+}
+
+// String implements the Stringer interface.
+func (x AnnotationShade) String() string {
+	if str, ok := _AnnotationShadeMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("AnnotationShade(%d)", x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x AnnotationShade) IsValid() bool {
+	_, ok := _AnnotationShadeMap[x]
+	return ok
+}
+
+var _AnnotationShadeValue = map[string]AnnotationShade{
+	_AnnotationShadeName[0:4]: AnnotationShadeDark,
+	_AnnotationShadeName[4:9]: AnnotationShadeLight,
+}
+
+// ParseAnnotationShade attempts to convert a string to a AnnotationShade.
+func ParseAnnotationShade(name string) (AnnotationShade, error) {
+	if x, ok := _AnnotationShadeValue[name]; ok {
+		return x, nil
+	}
+	return AnnotationShade(0), fmt.Errorf("%s is %w", name, ErrInvalidAnnotationShade)
+}
+
+const (
+	// StopSignal is a AnnotationSignal of type Stop.
+	StopSignal AnnotationSignal = iota
+	// GoSignal is a AnnotationSignal of type Go.
+	GoSignal
+)
+
+var ErrInvalidAnnotationSignal = errors.New("not a valid AnnotationSignal")
+
+const _AnnotationSignalName = "stopgo"
+
+var _AnnotationSignalMap = map[AnnotationSignal]string{
+	StopSignal: _AnnotationSignalName[0:4],
+	GoSignal:   _AnnotationSignalName[4:6],
+}
+
+// String implements the Stringer interface.
+func (x AnnotationSignal) String() string {
+	if str, ok := _AnnotationSignalMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("AnnotationSignal(%d)", x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x AnnotationSignal) IsValid() bool {
+	_, ok := _AnnotationSignalMap[x]
+	return ok
+}
+
+var _AnnotationSignalValue = map[string]AnnotationSignal{
+	_AnnotationSignalName[0:4]: StopSignal,
+	_AnnotationSignalName[4:6]: GoSignal,
+}
+
+// ParseAnnotationSignal attempts to convert a string to a AnnotationSignal.
+func ParseAnnotationSignal(name string) (AnnotationSignal, error) {
+	if x, ok := _AnnotationSignalValue[name]; ok {
+		return x, nil
+	}
+	return AnnotationSignal(0), fmt.Errorf("%s is %w", name, ErrInvalidAnnotationSignal)
+}
+
+const (
+	// AnnotationSizeSmall is a AnnotationSize of type Small.
+	AnnotationSizeSmall AnnotationSize = iota
+	// AnnotationSizeMedium is a AnnotationSize of type Medium.
+	AnnotationSizeMedium
+	// AnnotationSizeLarge is a AnnotationSize of type Large.
+	AnnotationSizeLarge
+)
+
+var ErrInvalidAnnotationSize = errors.New("not a valid AnnotationSize")
+
+// _AnnotationSizeParseError is the error of a failed parse, worded by the @errfmt format.
+// It wraps ErrInvalidAnnotationSize, so errors.Is keeps matching it.
+type _AnnotationSizeParseError string
+
+func (e _AnnotationSizeParseError) Error() string {
+	return fmt.Sprintf("%q n'est pas une valeur de %s", string(e), "AnnotationSize")
+}
+
+func (e _AnnotationSizeParseError) Unwrap() error {
+	return ErrInvalidAnnotationSize
+}
+
+const _AnnotationSizeName = "smallmediumlarge"
+
+var _AnnotationSizeMap = map[AnnotationSize]string{
+	AnnotationSizeSmall:  _AnnotationSizeName[0:5],
+	AnnotationSizeMedium: _AnnotationSizeName[5:11],
+	AnnotationSizeLarge:  _AnnotationSizeName[11:16],
+}
+
+// String implements the Stringer interface.
+func (x AnnotationSize) String() string {
+	if str, ok := _AnnotationSizeMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("AnnotationSize(%d)", x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x AnnotationSize) IsValid() bool {
+	_, ok := _AnnotationSizeMap[x]
+	return ok
+}
+
+var _AnnotationSizeValue = map[string]AnnotationSize{
+	_AnnotationSizeName[0:5]:   AnnotationSizeSmall,
+	_AnnotationSizeName[5:11]:  AnnotationSizeMedium,
+	_AnnotationSizeName[11:16]: AnnotationSizeLarge,
+}
+
+// ParseAnnotationSize attempts to convert a string to a AnnotationSize.
+func ParseAnnotationSize(name string) (AnnotationSize, error) {
+	if x, ok := _AnnotationSizeValue[name]; ok {
+		return x, nil
+	}
+	return AnnotationSize(0), _AnnotationSizeParseError(name)
+}
+
+// ParseAnnotationSizeBytes converts a byte slice to a AnnotationSize like ParseAnnotationSize,
+// looking it up without converting it to a string first so parsing a valid name doesn't allocate.
+func ParseAnnotationSizeBytes(b []byte) (AnnotationSize, error) {
+	if x, ok := _AnnotationSizeValue[string(b)]; ok {
+		return x, nil
+	}
+	return AnnotationSize(0), _AnnotationSizeParseError(b)
+}
+
+const (
+	// AnnotationStageInProgress is a AnnotationStage of type InProgress.
+	AnnotationStageInProgress AnnotationStage = iota
+	// AnnotationStageOnHold is a AnnotationStage of type OnHold.
+	AnnotationStageOnHold
+	// AnnotationStageDone is a AnnotationStage of type Done.
+	AnnotationStageDone
+)
+
+var ErrInvalidAnnotationStage = errors.New("not a valid AnnotationStage")
+
+const _AnnotationStageName = "InProgressOnHoldDone"
+
+var _AnnotationStageMap = map[AnnotationStage]string{
+	AnnotationStageInProgress: _AnnotationStageName[0:10],
+	AnnotationStageOnHold:     _AnnotationStageName[10:16],
+	AnnotationStageDone:       _AnnotationStageName[16:20],
+}
+
+// String implements the Stringer interface.
+func (x AnnotationStage) String() string {
+	if str, ok := _AnnotationStageMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("AnnotationStage(%d)", x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x AnnotationStage) IsValid() bool {
+	_, ok := _AnnotationStageMap[x]
+	return ok
+}
+
+var _AnnotationStageValue = map[string]AnnotationStage{
+	_AnnotationStageName[0:10]:  AnnotationStageInProgress,
+	_AnnotationStageName[10:16]: AnnotationStageOnHold,
+	_AnnotationStageName[16:20]: AnnotationStageDone,
+}
+
+var _AnnotationStageLowerValue = map[string]AnnotationStage{
+	strings.ToLower(_AnnotationStageName[0:10]):  AnnotationStageInProgress,
+	strings.ToLower(_AnnotationStageName[10:16]): AnnotationStageOnHold,
+	strings.ToLower(_AnnotationStageName[16:20]): AnnotationStageDone,
+}
+
+// ParseAnnotationStage attempts to convert a string to a AnnotationStage.
+func ParseAnnotationStage(name string) (AnnotationStage, error) {
+	if x, ok := _AnnotationStageValue[name]; ok {
+		return x, nil
+	}
+	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of lowercasing a string if we don't need to.
+	// Inputs too long to lowercase into a name are not normalized at all.
+	if len(name) <= 40 {
+		if x, ok := _AnnotationStageLowerValue[strings.ToLower(name)]; ok {
+			return x, nil
+		}
+	}
+	return AnnotationStage(0), fmt.Errorf("%s is %w", name, ErrInvalidAnnotationStage)
+}
+
+var errAnnotationStageNilPtr = errors.New("value pointer is nil") // one per type for package clashes
+
+// Scan implements the Scanner interface.
+func (x *AnnotationStage) Scan(value interface{}) (err error) {
+	if value == nil {
+		*x = AnnotationStage(0)
+		return
+	}
+
+	// sql.RawBytes is a distinct type, treat it like any other []byte.
+	if raw, ok := value.(sql.RawBytes); ok {
+		value = []byte(raw)
+	}
+
+	// A wider range of scannable types.
+	// driver.Value values at the top of the list for expediency
+	switch v := value.(type) {
+	case int64:
+		*x, err = _AnnotationStageFromInt64(v)
+	case string:
+		*x, err = ParseAnnotationStage(v)
+	case []byte:
+		*x, err = ParseAnnotationStage(string(v))
+	case AnnotationStage:
+		// An already typed value is assigned as is, once it is known to be declared
+		if !v.IsValid() {
+			return fmt.Errorf("%d is %w", v, ErrInvalidAnnotationStage)
+		}
+		*x = v
+	case *AnnotationStage:
+		if v == nil {
+			return errAnnotationStageNilPtr
+		}
+		if !v.IsValid() {
+			return fmt.Errorf("%d is %w", *v, ErrInvalidAnnotationStage)
+		}
+		*x = *v
+	case int:
+		*x, err = _AnnotationStageFromInt64(int64(v))
+	case int8:
+		*x, err = _AnnotationStageFromInt64(int64(v))
+	case int16:
+		*x, err = _AnnotationStageFromInt64(int64(v))
+	case int32:
+		*x, err = _AnnotationStageFromInt64(int64(v))
+	case uint:
+		*x, err = _AnnotationStageFromUint64(uint64(v))
+	case uint8:
+		*x, err = _AnnotationStageFromUint64(uint64(v))
+	case uint16:
+		*x, err = _AnnotationStageFromUint64(uint64(v))
+	case uint32:
+		*x, err = _AnnotationStageFromUint64(uint64(v))
+	case uint64:
+		*x, err = _AnnotationStageFromUint64(v)
+	case *int:
+		if v == nil {
+			return errAnnotationStageNilPtr
+		}
+		*x, err = _AnnotationStageFromInt64(int64(*v))
+	case *int64:
+		if v == nil {
+			return errAnnotationStageNilPtr
+		}
+		*x, err = _AnnotationStageFromInt64(*v)
+	case float64: // json marshals everything as a float64 if it's a number
+		*x, err = _AnnotationStageFromFloat64(v)
+	case *float64: // json marshals everything as a float64 if it's a number
+		if v == nil {
+			return errAnnotationStageNilPtr
+		}
+		*x, err = _AnnotationStageFromFloat64(*v)
+	case *uint:
+		if v == nil {
+			return errAnnotationStageNilPtr
+		}
+		*x, err = _AnnotationStageFromUint64(uint64(*v))
+	case *uint64:
+		if v == nil {
+			return errAnnotationStageNilPtr
+		}
+		*x, err = _AnnotationStageFromUint64(*v)
+	case *string:
+		if v == nil {
+			return errAnnotationStageNilPtr
+		}
+		*x, err = ParseAnnotationStage(*v)
+	default:
+		return fmt.Errorf("invalid type %T for AnnotationStage", value)
+	}
+
+	return
+}
+
+// _AnnotationStageFromInt64 converts an integer read from the database, failing when it does not fit in AnnotationStage
+// or is not one of its values.
+func _AnnotationStageFromInt64(v int64) (AnnotationStage, error) {
+	x := AnnotationStage(v)
+	if int64(x) != v || !x.IsValid() {
+		return AnnotationStage(0), fmt.Errorf("%d is %w", v, ErrInvalidAnnotationStage)
+	}
+	return x, nil
+}
+
+// _AnnotationStageFromUint64 converts an unsigned integer read from the database, like _AnnotationStageFromInt64.
+func _AnnotationStageFromUint64(v uint64) (AnnotationStage, error) {
+	if v > math.MaxInt64 {
+		return AnnotationStage(0), fmt.Errorf("%d is %w", v, ErrInvalidAnnotationStage)
+	}
+	return _AnnotationStageFromInt64(int64(v))
+}
+
+// _AnnotationStageFromFloat64 converts a number decoded as a float64, like _AnnotationStageFromInt64, failing when it is not a whole number.
+func _AnnotationStageFromFloat64(v float64) (AnnotationStage, error) {
+	if v != math.Trunc(v) || v < math.MinInt64 || v >= math.MaxInt64 {
+		return AnnotationStage(0), fmt.Errorf("%v is %w", v, ErrInvalidAnnotationStage)
+	}
+	return _AnnotationStageFromInt64(int64(v))
+}
+
+// Value implements the driver Valuer interface.
+func (x AnnotationStage) Value() (driver.Value, error) {
+	return x.String(), nil
+}
+
+const (
+	// AnnotationStatePending is a AnnotationState of type pending.
+	AnnotationStatePending AnnotationState = "pending"
+	// AnnotationStateRunning is a AnnotationState of type running.
+	AnnotationStateRunning AnnotationState = "running"
+	// AnnotationStateCompleted is a AnnotationState of type completed.
+	AnnotationStateCompleted AnnotationState = "completed"
+	// AnnotationStateFailed is a AnnotationState of type failed.
+	AnnotationStateFailed AnnotationState = "failed"
+)
+
+// AnnotationStateDoc is the description of AnnotationState given by its @comment annotation.
+const AnnotationStateDoc = "Lifecycle states of a job"
+
+var ErrInvalidAnnotationState = errors.New("not a valid AnnotationState")
+
+// String implements the Stringer interface.
+func (x AnnotationState) String() string {
+	return string(x)
+}
+
+// _AnnotationStateSet holds the declared values of AnnotationState for IsValid.
+var _AnnotationStateSet = map[AnnotationState]struct{}{
+	AnnotationStatePending:   {},
+	AnnotationStateRunning:   {},
+	AnnotationStateCompleted: {},
+	AnnotationStateFailed:    {},
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x AnnotationState) IsValid() bool {
+	_, ok := _AnnotationStateSet[x]
+	return ok
+}
+
+var _AnnotationStateValue = map[string]AnnotationState{
+	"pending":   AnnotationStatePending,
+	"running":   AnnotationStateRunning,
+	"completed": AnnotationStateCompleted,
+	"failed":    AnnotationStateFailed,
+}
+
+// ParseAnnotationState attempts to convert a string to a AnnotationState.
+func ParseAnnotationState(name string) (AnnotationState, error) {
+	name = strings.TrimSpace(name)
+	if x, ok := _AnnotationStateValue[name]; ok {
+		return x, nil
+	}
+	return AnnotationState(""), fmt.Errorf("%s is %w", name, ErrInvalidAnnotationState)
+}
+
+// MarshalText implements the text marshaller method.
+func (x AnnotationState) MarshalText() ([]byte, error) {
+	return x.AppendText(nil)
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *AnnotationState) UnmarshalText(text []byte) error {
+	tmp, err := ParseAnnotationState(string(text))
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// AppendText appends the textual representation of itself to the end of b
+// (allocating a larger slice if necessary) and returns the updated slice.
+//
+// Implementations must not retain b, nor mutate any bytes within b[:len(b)].
+func (x AnnotationState) AppendText(b []byte) ([]byte, error) {
+	return append(b, x.String()...), nil
+}
+
+const (
+	// MyAnnotationStatusPending is a AnnotationStatus of type pending.
+	MyAnnotationStatusPending AnnotationStatus = "pending"
+	// MyAnnotationStatusRunning is a AnnotationStatus of type running.
+	MyAnnotationStatusRunning AnnotationStatus = "running"
+	// MyAnnotationStatusCompleted is a AnnotationStatus of type completed.
+	MyAnnotationStatusCompleted AnnotationStatus = "completed"
+	// MyAnnotationStatusFailed is a AnnotationStatus of type failed.
+	MyAnnotationStatusFailed AnnotationStatus = "failed"
+)
+
+var ErrInvalidAnnotationStatus = fmt.Errorf("not a valid AnnotationStatus, try [%s]", strings.Join(_AnnotationStatusNames, ", "))
+
+var _AnnotationStatusNames = []string{
+	string(MyAnnotationStatusPending),
+	string(MyAnnotationStatusRunning),
+	string(MyAnnotationStatusCompleted),
+	string(MyAnnotationStatusFailed),
+}
+
+// AnnotationStatusNames returns a list of possible string values of AnnotationStatus.
+func AnnotationStatusNames() []string {
+	tmp := make([]string, len(_AnnotationStatusNames))
+	copy(tmp, _AnnotationStatusNames)
+	return tmp
+}
+
+// AnnotationStatusValues returns a list of the values for AnnotationStatus
+func AnnotationStatusValues() []AnnotationStatus {
+	return []AnnotationStatus{
+		MyAnnotationStatusPending,
+		MyAnnotationStatusRunning,
+		MyAnnotationStatusCompleted,
+		MyAnnotationStatusFailed,
+	}
+}
+
+// String implements the Stringer interface.
+func (x AnnotationStatus) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x AnnotationStatus) IsValid() bool {
+	_, err := ParseAnnotationStatus(string(x))
+	return err == nil
+}
+
+var _AnnotationStatusValue = map[string]AnnotationStatus{
+	"pending":   MyAnnotationStatusPending,
+	"running":   MyAnnotationStatusRunning,
+	"completed": MyAnnotationStatusCompleted,
+	"failed":    MyAnnotationStatusFailed,
+}
+
+// ParseAnnotationStatus attempts to convert a string to a AnnotationStatus.
+func ParseAnnotationStatus(name string) (AnnotationStatus, error) {
+	if x, ok := _AnnotationStatusValue[name]; ok {
+		return x, nil
+	}
+	return AnnotationStatus(""), fmt.Errorf("%s is %w", name, ErrInvalidAnnotationStatus)
+}
+
+// lookupAnnotationStatus converts a string to a AnnotationStatus, reporting whether it is valid instead of building an error.
+func lookupAnnotationStatus(name string) (AnnotationStatus, bool) {
+	if x, ok := _AnnotationStatusValue[name]; ok {
+		return x, true
+	}
+	return AnnotationStatus(""), false
+}
+
+// ParseAnnotationStatusOrDefault converts a string to a AnnotationStatus, returning def when it is not valid.
+func ParseAnnotationStatusOrDefault(name string, def AnnotationStatus) AnnotationStatus {
+	if x, ok := lookupAnnotationStatus(name); ok {
+		return x
+	}
+	return def
+}
+
+// MustParseAnnotationStatus converts a string to a AnnotationStatus, and panics if is not valid.
+func MustParseAnnotationStatus(name string) AnnotationStatus {
+	val, err := ParseAnnotationStatus(name)
+	if err != nil {
+		panic(err)
+	}
+	return val
+}
+
+// AnnotationStatusOpenAPISchema returns an OpenAPI schema fragment describing the allowed values of AnnotationStatus,
+// with the names of their constants in the same order as x-enum-varnames.
+func AnnotationStatusOpenAPISchema() map[string]interface{} {
+	return map[string]interface{}{
+		"type": "string",
+		"enum": []interface{}{
+			MyAnnotationStatusPending.String(),
+			MyAnnotationStatusRunning.String(),
+			MyAnnotationStatusCompleted.String(),
+			MyAnnotationStatusFailed.String(),
+		},
+		"x-enum-varnames": []interface{}{
+			"MyAnnotationStatusPending",
+			"MyAnnotationStatusRunning",
+			"MyAnnotationStatusCompleted",
+			"MyAnnotationStatusFailed",
+		},
+	}
+}
+
+// LogValue implements slog.LogValuer, so AnnotationStatus logs as its name.
+func (x AnnotationStatus) LogValue() slog.Value {
+	return slog.StringValue(x.String())
+}
+
+// MarshalCSV implements the gocsv.TypeMarshaller interface, writing the name of the value.
+func (x AnnotationStatus) MarshalCSV() (string, error) {
+	if !x.IsValid() {
+		return "", fmt.Errorf("%s is %w", string(x), ErrInvalidAnnotationStatus)
+	}
+	return x.String(), nil
+}
+
+// UnmarshalCSV implements the gocsv.TypeUnmarshaller interface, parsing the name of a value.
+func (x *AnnotationStatus) UnmarshalCSV(field string) error {
+	tmp, err := ParseAnnotationStatus(field)
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// AppendJSON appends the JSON of x to b, the same as json.Marshal writing its quoted name,
+// and returns the updated slice.
+func (x AnnotationStatus) AppendJSON(b []byte) ([]byte, error) {
+	if !x.IsValid() {
+		return nil, fmt.Errorf("%s is %w", string(x), ErrInvalidAnnotationStatus)
+	}
+	b = append(b, '"')
+	b = append(b, x.String()...)
+	return append(b, '"'), nil
+}
+
+// AnnotationStatusContains returns whether x is a declared AnnotationStatus, the function form of IsValid.
+func AnnotationStatusContains(x AnnotationStatus) bool {
+	return x.IsValid()
+}
+
+// AnnotationStatusContainsString returns whether s parses to a AnnotationStatus.
+func AnnotationStatusContainsString(s string) bool {
+	_, err := ParseAnnotationStatus(s)
+	return err == nil
+}
+
+// ParseAnnotationStatusBytes converts a byte slice to a AnnotationStatus like ParseAnnotationStatus,
+// looking it up without converting it to a string first so parsing a valid name doesn't allocate.
+func ParseAnnotationStatusBytes(b []byte) (AnnotationStatus, error) {
+	if x, ok := _AnnotationStatusValue[string(b)]; ok {
+		return x, nil
+	}
+	return AnnotationStatus(""), fmt.Errorf("%s is %w", b, ErrInvalidAnnotationStatus)
+}
+
+// ParseAnnotationStatusBatch converts every input to a AnnotationStatus instead of stopping at the first invalid one.
+// The results and errors are parallel to inputs, errs[i] is nil when inputs[i] is valid.
+func ParseAnnotationStatusBatch(inputs []string) (results []AnnotationStatus, errs []error) {
+	results = make([]AnnotationStatus, len(inputs))
+	errs = make([]error, len(inputs))
+	for i, input := range inputs {
+		results[i], errs[i] = ParseAnnotationStatus(input)
+	}
+	return results, errs
+}
+
+// Ptr returns a pointer to a copy of x, for use in optional fields.
+func (x AnnotationStatus) Ptr() *AnnotationStatus {
+	return &x
+}
+
+// PtrString returns the name of the value x points to, or "" when x is nil, so optional fields print without a nil check.
+func (x *AnnotationStatus) PtrString() string {
+	if x == nil {
+		return ""
+	}
+	return x.String()
+}
+
+// AnnotationStatusPtr returns a pointer to a copy of v, for use in optional fields.
+func AnnotationStatusPtr(v AnnotationStatus) *AnnotationStatus {
+	return &v
+}
+
+// MarshalText implements the text marshaller method.
+func (x AnnotationStatus) MarshalText() ([]byte, error) {
+	return x.AppendText(nil)
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *AnnotationStatus) UnmarshalText(text []byte) error {
+	tmp, err := ParseAnnotationStatus(string(text))
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// AppendText appends the textual representation of itself to the end of b
+// (allocating a larger slice if necessary) and returns the updated slice.
+//
+// Implementations must not retain b, nor mutate any bytes within b[:len(b)].
+func (x AnnotationStatus) AppendText(b []byte) ([]byte, error) {
+	if !x.IsValid() {
+		return nil, fmt.Errorf("%s is %w", string(x), ErrInvalidAnnotationStatus)
+	}
+	return append(b, x.String()...), nil
+}
+
+// MarshalXML implements the xml.Marshaler interface.
+func (x AnnotationStatus) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return e.EncodeElement(x.String(), start)
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface.
+func (x *AnnotationStatus) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var name string
+	if err := d.DecodeElement(&name, &start); err != nil {
+		return err
+	}
+	tmp, err := ParseAnnotationStatus(name)
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// MarshalXMLAttr implements the xml.MarshalerAttr interface.
+func (x AnnotationStatus) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return xml.Attr{Name: name, Value: x.String()}, nil
+}
+
+// UnmarshalXMLAttr implements the xml.UnmarshalerAttr interface.
+func (x *AnnotationStatus) UnmarshalXMLAttr(attr xml.Attr) error {
+	tmp, err := ParseAnnotationStatus(attr.Value)
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// AnnotationStatusAll returns an iterator over the values of AnnotationStatus in declaration order.
+func AnnotationStatusAll() iter.Seq[AnnotationStatus] {
+	return func(yield func(AnnotationStatus) bool) {
+		for _, x := range []AnnotationStatus{
+			MyAnnotationStatusPending,
+			MyAnnotationStatusRunning,
+			MyAnnotationStatusCompleted,
+			MyAnnotationStatusFailed,
+		} {
+			if !yield(x) {
+				return
+			}
+		}
+	}
+}
+
+// Next returns the value declared after x, wrapping around to the first one.
+func (x AnnotationStatus) Next() AnnotationStatus {
+	switch x {
+	case MyAnnotationStatusPending:
+		return MyAnnotationStatusRunning
+	case MyAnnotationStatusRunning:
+		return MyAnnotationStatusCompleted
+	case MyAnnotationStatusCompleted:
+		return MyAnnotationStatusFailed
+	case MyAnnotationStatusFailed:
+		return MyAnnotationStatusPending
+	}
+	return x
+}
+
+// Prev returns the value declared before x, wrapping around to the last one.
+func (x AnnotationStatus) Prev() AnnotationStatus {
+	switch x {
+	case MyAnnotationStatusPending:
+		return MyAnnotationStatusFailed
+	case MyAnnotationStatusRunning:
+		return MyAnnotationStatusPending
+	case MyAnnotationStatusCompleted:
+		return MyAnnotationStatusRunning
+	case MyAnnotationStatusFailed:
+		return MyAnnotationStatusCompleted
+	}
+	return x
+}
+
+// _AnnotationStatusOrdinal holds the 0-based declaration position of each value.
+var _AnnotationStatusOrdinal = map[AnnotationStatus]int{
+	MyAnnotationStatusPending:   0,
+	MyAnnotationStatusRunning:   1,
+	MyAnnotationStatusCompleted: 2,
+	MyAnnotationStatusFailed:    3,
+}
+
+// Ordinal returns the 0-based declaration position of x, or -1 if x is not a declared value.
+// Unlike the string value itself, it can be used to sort values in declaration order.
+func (x AnnotationStatus) Ordinal() int {
+	if i, ok := _AnnotationStatusOrdinal[x]; ok {
+		return i
+	}
+	return -1
+}
+
+// Index returns the 0-based declaration position of x, or -1 if x is not a declared value.
+func (x AnnotationStatus) Index() int {
+	return x.Ordinal()
+}
+
+// Compare returns -1, 0 or +1 depending on whether x is declared before, at or after other.
+// Values that are not declared sort before all the declared ones.
+func (x AnnotationStatus) Compare(other AnnotationStatus) int {
+	return cmp.Compare(x.Index(), other.Index())
+}
+
+// AnnotationStatusFromIndex returns the AnnotationStatus declared at the 0-based position i.
+func AnnotationStatusFromIndex(i int) (AnnotationStatus, error) {
+	switch i {
+	case 0:
+		return MyAnnotationStatusPending, nil
+	case 1:
+		return MyAnnotationStatusRunning, nil
+	case 2:
+		return MyAnnotationStatusCompleted, nil
+	case 3:
+		return MyAnnotationStatusFailed, nil
+	}
+	return AnnotationStatus(""), fmt.Errorf("index %d is %w", i, ErrInvalidAnnotationStatus)
+}
+
+// _AnnotationStatusExhaustive references every value of AnnotationStatus in a switch, so removing a
+// value breaks the build and the exhaustive linter knows the complete list of cases.
+func _AnnotationStatusExhaustive(x AnnotationStatus) {
+	switch x {
+	case MyAnnotationStatusPending:
+	case MyAnnotationStatusRunning:
+	case MyAnnotationStatusCompleted:
+	case MyAnnotationStatusFailed:
+	}
+}
+
+var _ = _AnnotationStatusExhaustive
+
+// RandomAnnotationStatus returns a uniformly random declared AnnotationStatus, drawn from r.
+func RandomAnnotationStatus(r *rand.Rand) AnnotationStatus {
+	return _AnnotationStatusRandom[r.IntN(len(_AnnotationStatusRandom))]
+}
+
+// RandomAnnotationStatusGlobal returns a uniformly random declared AnnotationStatus, drawn from the global source.
+func RandomAnnotationStatusGlobal() AnnotationStatus {
+	return _AnnotationStatusRandom[rand.IntN(len(_AnnotationStatusRandom))]
+}
+
+var _AnnotationStatusRandom = []AnnotationStatus{
+	MyAnnotationStatusPending,
+	MyAnnotationStatusRunning,
+	MyAnnotationStatusCompleted,
+	MyAnnotationStatusFailed,
+}
+
+// IsPending reports whether x is MyAnnotationStatusPending.
+func (x AnnotationStatus) IsPending() bool {
+	return x == MyAnnotationStatusPending
+}
+
+// IsRunning reports whether x is MyAnnotationStatusRunning.
+func (x AnnotationStatus) IsRunning() bool {
+	return x == MyAnnotationStatusRunning
+}
+
+// IsCompleted reports whether x is MyAnnotationStatusCompleted.
+func (x AnnotationStatus) IsCompleted() bool {
+	return x == MyAnnotationStatusCompleted
+}
+
+// IsFailed reports whether x is MyAnnotationStatusFailed.
+func (x AnnotationStatus) IsFailed() bool {
+	return x == MyAnnotationStatusFailed
+}
+
+// Validate returns an error wrapping ErrInvalidAnnotationStatus when x is not a valid AnnotationStatus.
+func (x AnnotationStatus) Validate() error {
+	if !x.IsValid() {
+		return fmt.Errorf("%s is %w", string(x), ErrInvalidAnnotationStatus)
+	}
+	return nil
+}
+
+// AnnotationStatusOneOf returns the valid values of AnnotationStatus separated by spaces, for the oneof rule of
+// go-playground/validator: `validate:"oneof=pending running completed failed"`.
+func AnnotationStatusOneOf() string {
+	return "pending running completed failed"
+}
+
+// AnnotationStatusNameMap returns a copy of the map from the names to the values of AnnotationStatus.
+func AnnotationStatusNameMap() map[string]AnnotationStatus {
+	return map[string]AnnotationStatus{
+		string(MyAnnotationStatusPending):   MyAnnotationStatusPending,
+		string(MyAnnotationStatusRunning):   MyAnnotationStatusRunning,
+		string(MyAnnotationStatusCompleted): MyAnnotationStatusCompleted,
+		string(MyAnnotationStatusFailed):    MyAnnotationStatusFailed,
+	}
+}
+
+// AnnotationStatusValueMap returns a copy of the map from the values of AnnotationStatus to their names.
+func AnnotationStatusValueMap() map[AnnotationStatus]string {
+	return map[AnnotationStatus]string{
+		MyAnnotationStatusPending:   string(MyAnnotationStatusPending),
+		MyAnnotationStatusRunning:   string(MyAnnotationStatusRunning),
+		MyAnnotationStatusCompleted: string(MyAnnotationStatusCompleted),
+		MyAnnotationStatusFailed:    string(MyAnnotationStatusFailed),
+	}
+}
+
+// ParseAnnotationStatusList converts a comma separated list of strings to AnnotationStatus values.
+// An empty string returns an empty list.
+func ParseAnnotationStatusList(s string) ([]AnnotationStatus, error) {
+	list := []AnnotationStatus{}
+	if strings.TrimSpace(s) == "" {
+		return list, nil
+	}
+	for _, name := range strings.Split(s, ",") {
+		x, err := ParseAnnotationStatus(strings.TrimSpace(name))
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, x)
+	}
+	return list, nil
+}
+
+// AnnotationStatusJSONSchema returns a JSON schema fragment describing the allowed values of AnnotationStatus.
+func AnnotationStatusJSONSchema() map[string]interface{} {
+	return map[string]interface{}{
+		"type": "string",
+		"enum": []interface{}{
+			MyAnnotationStatusPending.String(),
+			MyAnnotationStatusRunning.String(),
+			MyAnnotationStatusCompleted.String(),
+			MyAnnotationStatusFailed.String(),
+		},
+	}
+}
+
+// Set implements the Golang flag.Value interface func.
+func (x *AnnotationStatus) Set(val string) error {
+	v, err := ParseAnnotationStatus(val)
+	*x = v
+	return err
+}
+
+// Get implements the Golang flag.Getter interface func.
+func (x *AnnotationStatus) Get() interface{} {
+	return *x
+}
+
+// Type implements the github.com/spf13/pFlag Value interface.
+func (x *AnnotationStatus) Type() string {
+	return "AnnotationStatus"
+}
+
+const (
+	// AnnotationTicketUnknown is a AnnotationTicket of type unknown.
+	AnnotationTicketUnknown AnnotationTicket = "unknown"
+	// AnnotationTicketOpen is a AnnotationTicket of type open.
+	AnnotationTicketOpen AnnotationTicket = "open"
+	// AnnotationTicketClosed is a AnnotationTicket of type closed.
+	AnnotationTicketClosed AnnotationTicket = "closed"
+)
+
+var ErrInvalidAnnotationTicket = errors.New("not a valid AnnotationTicket")
+
+// AnnotationTicketDefault is the default value of AnnotationTicket.
+const AnnotationTicketDefault = AnnotationTicketUnknown
+
+// String implements the Stringer interface.
+func (x AnnotationTicket) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x AnnotationTicket) IsValid() bool {
+	if x == "" {
+		return false
+	}
+	_, err := ParseAnnotationTicket(string(x))
+	return err == nil
+}
+
+var _AnnotationTicketValue = map[string]AnnotationTicket{
+	"unknown": AnnotationTicketUnknown,
+	"open":    AnnotationTicketOpen,
+	"closed":  AnnotationTicketClosed,
+}
+
+// ParseAnnotationTicket attempts to convert a string to a AnnotationTicket.
+func ParseAnnotationTicket(name string) (AnnotationTicket, error) {
+	if name == "" {
+		return AnnotationTicketDefault, nil
+	}
+	if x, ok := _AnnotationTicketValue[name]; ok {
+		return x, nil
+	}
+	return AnnotationTicket(""), fmt.Errorf("%s is %w", name, ErrInvalidAnnotationTicket)
+}
+
+// IsZero reports whether x is the zero value of AnnotationTicket or its default value, which marshals as null in JSON.
+// It also makes the `omitzero` option of encoding/json omit the field.
+func (x AnnotationTicket) IsZero() bool {
+	return x == AnnotationTicket("") || x == AnnotationTicketUnknown
+}
+
+// MarshalJSON implements the json.Marshaler interface, writing null for the zero value.
+func (x AnnotationTicket) MarshalJSON() ([]byte, error) {
+	if x.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(string(x))
+}
+
+var errAnnotationTicketNilPtr = errors.New("value pointer is nil") // one per type for package clashes
+
+// Scan implements the Scanner interface.
+func (x *AnnotationTicket) Scan(value interface{}) (err error) {
+	if value == nil {
+		*x = AnnotationTicketDefault
+		return
+	}
+
+	// sql.RawBytes is a distinct type, treat it like any other []byte.
+	if raw, ok := value.(sql.RawBytes); ok {
+		value = []byte(raw)
+	}
+
+	// A wider range of scannable types.
+	// driver.Value values at the top of the list for expediency
+	switch v := value.(type) {
+	case string:
+		*x, err = ParseAnnotationTicket(v)
+	case []byte:
+		*x, err = ParseAnnotationTicket(string(v))
+	case AnnotationTicket:
+		// An already typed value is assigned as is, once it is known to be declared
+		if !v.IsValid() {
+			return fmt.Errorf("%s is %w", string(v), ErrInvalidAnnotationTicket)
+		}
+		*x = v
+	case *AnnotationTicket:
+		if v == nil {
+			return errAnnotationTicketNilPtr
+		}
+		if !v.IsValid() {
+			return fmt.Errorf("%s is %w", string(*v), ErrInvalidAnnotationTicket)
+		}
+		*x = *v
+	case *string:
+		if v == nil {
+			return errAnnotationTicketNilPtr
+		}
+		*x, err = ParseAnnotationTicket(*v)
+	default:
+		return fmt.Errorf("invalid type %T for AnnotationTicket", value)
+	}
+
+	return
+}
+
+// Value implements the driver Valuer interface.
+func (x AnnotationTicket) Value() (driver.Value, error) {
+	return x.String(), nil
+}
+
+const (
+	// AnnotationTierBronze is a AnnotationTier of type Bronze.
+	AnnotationTierBronze AnnotationTier = iota
+	// AnnotationTierSilver is a AnnotationTier of type Silver.
+	AnnotationTierSilver
+	// AnnotationTierGold is a AnnotationTier of type Gold.
+	AnnotationTierGold
+)
+
+var ErrInvalidAnnotationTier = errors.New("not a valid AnnotationTier")
+
+const _AnnotationTierName = "bronzesilvergold"
+
+var _AnnotationTierMap = map[AnnotationTier]string{
+	AnnotationTierBronze: _AnnotationTierName[0:6],
+	AnnotationTierSilver: _AnnotationTierName[6:12],
+	AnnotationTierGold:   _AnnotationTierName[12:16],
+}
+
+// String implements the Stringer interface.
+func (x AnnotationTier) String() string {
+	if str, ok := _AnnotationTierMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("AnnotationTier(%d)", x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x AnnotationTier) IsValid() bool {
+	_, ok := _AnnotationTierMap[x]
+	return ok
+}
+
+var _AnnotationTierValue = map[string]AnnotationTier{
+	_AnnotationTierName[0:6]:   AnnotationTierBronze,
+	_AnnotationTierName[6:12]:  AnnotationTierSilver,
+	_AnnotationTierName[12:16]: AnnotationTierGold,
+}
+
+// ParseAnnotationTier attempts to convert a string to a AnnotationTier.
+func ParseAnnotationTier(name string) (AnnotationTier, error) {
+	if x, ok := _AnnotationTierValue[name]; ok {
+		return x, nil
+	}
+	return AnnotationTier(0), fmt.Errorf("%s is %w", name, ErrInvalidAnnotationTier)
+}
+
+var errAnnotationTierNilPtr = errors.New("value pointer is nil") // one per type for package clashes
+
+// Scan implements the Scanner interface.
+func (x *AnnotationTier) Scan(value interface{}) (err error) {
+	if value == nil {
+		*x = AnnotationTier(0)
+		return
+	}
+
+	// sql.RawBytes is a distinct type, treat it like any other []byte.
+	if raw, ok := value.(sql.RawBytes); ok {
+		value = []byte(raw)
+	}
+
+	// A wider range of scannable types.
+	// driver.Value values at the top of the list for expediency
+	switch v := value.(type) {
+	case int64:
+		*x, err = _AnnotationTierFromInt64(v)
+	case string:
+		*x, err = ParseAnnotationTier(v)
+		if err != nil {
+			// try parsing the integer value as a string
+			if val, verr := strconv.Atoi(v); verr == nil {
+				*x, err = _AnnotationTierFromInt64(int64(val))
+			}
+		}
+	case []byte:
+		*x, err = ParseAnnotationTier(string(v))
+		if err != nil {
+			// try parsing the integer value as a string
+			if val, verr := strconv.Atoi(string(v)); verr == nil {
+				*x, err = _AnnotationTierFromInt64(int64(val))
+			}
+		}
+	case AnnotationTier:
+		// An already typed value is assigned as is, once it is known to be declared
+		if !v.IsValid() {
+			return fmt.Errorf("%d is %w", v, ErrInvalidAnnotationTier)
+		}
+		*x = v
+	case *AnnotationTier:
+		if v == nil {
+			return errAnnotationTierNilPtr
+		}
+		if !v.IsValid() {
+			return fmt.Errorf("%d is %w", *v, ErrInvalidAnnotationTier)
+		}
+		*x = *v
+	case int:
+		*x, err = _AnnotationTierFromInt64(int64(v))
+	case int8:
+		*x, err = _AnnotationTierFromInt64(int64(v))
+	case int16:
+		*x, err = _AnnotationTierFromInt64(int64(v))
+	case int32:
+		*x, err = _AnnotationTierFromInt64(int64(v))
+	case uint:
+		*x, err = _AnnotationTierFromUint64(uint64(v))
+	case uint8:
+		*x, err = _AnnotationTierFromUint64(uint64(v))
+	case uint16:
+		*x, err = _AnnotationTierFromUint64(uint64(v))
+	case uint32:
+		*x, err = _AnnotationTierFromUint64(uint64(v))
+	case uint64:
+		*x, err = _AnnotationTierFromUint64(v)
+	case *int:
+		if v == nil {
+			return errAnnotationTierNilPtr
+		}
+		*x, err = _AnnotationTierFromInt64(int64(*v))
+	case *int64:
+		if v == nil {
+			return errAnnotationTierNilPtr
+		}
+		*x, err = _AnnotationTierFromInt64(*v)
+	case float64: // json marshals everything as a float64 if it's a number
+		*x, err = _AnnotationTierFromFloat64(v)
+	case *float64: // json marshals everything as a float64 if it's a number
+		if v == nil {
+			return errAnnotationTierNilPtr
+		}
+		*x, err = _AnnotationTierFromFloat64(*v)
+	case *uint:
+		if v == nil {
+			return errAnnotationTierNilPtr
+		}
+		*x, err = _AnnotationTierFromUint64(uint64(*v))
+	case *uint64:
+		if v == nil {
+			return errAnnotationTierNilPtr
+		}
+		*x, err = _AnnotationTierFromUint64(*v)
+	case *string:
+		if v == nil {
+			return errAnnotationTierNilPtr
+		}
+		*x, err = ParseAnnotationTier(*v)
+		if err != nil {
+			// try parsing the integer value as a string
+			if val, verr := strconv.Atoi(*v); verr == nil {
+				*x, err = _AnnotationTierFromInt64(int64(val))
+			}
+		}
+	default:
+		return fmt.Errorf("invalid type %T for AnnotationTier", value)
+	}
+
+	return
+}
+
+// _AnnotationTierFromInt64 converts an integer read from the database, failing when it does not fit in AnnotationTier
+// or is not one of its values.
+func _AnnotationTierFromInt64(v int64) (AnnotationTier, error) {
+	x := AnnotationTier(v)
+	if int64(x) != v || !x.IsValid() {
+		return AnnotationTier(0), fmt.Errorf("%d is %w", v, ErrInvalidAnnotationTier)
+	}
+	return x, nil
+}
+
+// _AnnotationTierFromUint64 converts an unsigned integer read from the database, like _AnnotationTierFromInt64.
+func _AnnotationTierFromUint64(v uint64) (AnnotationTier, error) {
+	if v > math.MaxInt64 {
+		return AnnotationTier(0), fmt.Errorf("%d is %w", v, ErrInvalidAnnotationTier)
+	}
+	return _AnnotationTierFromInt64(int64(v))
+}
+
+// _AnnotationTierFromFloat64 converts a number decoded as a float64, like _AnnotationTierFromInt64, failing when it is not a whole number.
+func _AnnotationTierFromFloat64(v float64) (AnnotationTier, error) {
+	if v != math.Trunc(v) || v < math.MinInt64 || v >= math.MaxInt64 {
+		return AnnotationTier(0), fmt.Errorf("%v is %w", v, ErrInvalidAnnotationTier)
+	}
+	return _AnnotationTierFromInt64(int64(v))
+}
+
+// Value implements the driver Valuer interface.
+func (x AnnotationTier) Value() (driver.Value, error) {
+	return int64(x), nil
+}
+
+type NullAnnotationTier struct {
+	AnnotationTier AnnotationTier
+	Valid          bool
+}
+
+func NewNullAnnotationTier(val interface{}) (x NullAnnotationTier) {
+	x.Scan(val) // yes, we ignore this error, it will just be an invalid value.
+	return
+}
+
+// Scan implements the Scanner interface.
+func (x *NullAnnotationTier) Scan(value interface{}) (err error) {
+	if value == nil {
+		x.AnnotationTier, x.Valid = AnnotationTier(0), false
+		return
+	}
+
+	err = x.AnnotationTier.Scan(value)
+	x.Valid = (err == nil)
+	return
+}
+
+// Value implements the driver Valuer interface.
+func (x NullAnnotationTier) Value() (driver.Value, error) {
+	if !x.Valid {
+		return nil, nil
+	}
+	// driver.Value accepts int64 for int values.
+	return int64(x.AnnotationTier), nil
+}
+
+const (
+	// AnnotationUrgencyLow is a AnnotationUrgency of type Low.
+	AnnotationUrgencyLow AnnotationUrgency = iota + 1
+	// AnnotationUrgencyNormal is a AnnotationUrgency of type Normal.
+	AnnotationUrgencyNormal
+	// AnnotationUrgencyHigh is a AnnotationUrgency of type High.
+	AnnotationUrgencyHigh
+)
+
+var ErrInvalidAnnotationUrgency = errors.New("not a valid AnnotationUrgency")
+
+// AnnotationUrgencyDefault is the default value of AnnotationUrgency.
+const AnnotationUrgencyDefault = AnnotationUrgencyNormal
+
+const _AnnotationUrgencyName = "lownormalhigh"
+
+var _AnnotationUrgencyMap = map[AnnotationUrgency]string{
+	AnnotationUrgencyLow:    _AnnotationUrgencyName[0:3],
+	AnnotationUrgencyNormal: _AnnotationUrgencyName[3:9],
+	AnnotationUrgencyHigh:   _AnnotationUrgencyName[9:13],
+}
 
 // String implements the Stringer interface.
-func (x AnnotationColor) String() string {
-	return string(x)
+func (x AnnotationUrgency) String() string {
+	if str, ok := _AnnotationUrgencyMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("AnnotationUrgency(%d)", x)
 }
 
 // IsValid provides a quick way to determine if the typed value is
 // part of the allowed enumerated values
-func (x AnnotationColor) IsValid() bool {
-	_, err := ParseAnnotationColor(string(x))
-	return err == nil
+func (x AnnotationUrgency) IsValid() bool {
+	if x == 0 {
+		return true
+	}
+	_, ok := _AnnotationUrgencyMap[x]
+	return ok
 }
 
-var _AnnotationColorValue = map[string]AnnotationColor{
-	"annotation_red":   AnnotationRed,
-	"annotation_green": AnnotationGreen,
-	"annotation_blue":  AnnotationBlue,
+var _AnnotationUrgencyValue = map[string]AnnotationUrgency{
+	_AnnotationUrgencyName[0:3]:  AnnotationUrgencyLow,
+	_AnnotationUrgencyName[3:9]:  AnnotationUrgencyNormal,
+	_AnnotationUrgencyName[9:13]: AnnotationUrgencyHigh,
 }
 
-// ParseAnnotationColor attempts to convert a string to a AnnotationColor.
-func ParseAnnotationColor(name string) (AnnotationColor, error) {
-	if x, ok := _AnnotationColorValue[name]; ok {
+// ParseAnnotationUrgency attempts to convert a string to a AnnotationUrgency.
+func ParseAnnotationUrgency(name string) (AnnotationUrgency, error) {
+	if name == "" {
+		return AnnotationUrgencyDefault, nil
+	}
+	if x, ok := _AnnotationUrgencyValue[name]; ok {
 		return x, nil
 	}
-	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of lowercasing a string if we don't need to.
-	if x, ok := _AnnotationColorValue[strings.ToLower(name)]; ok {
+	return AnnotationUrgency(0), fmt.Errorf("%s is %w", name, ErrInvalidAnnotationUrgency)
+}
+
+// IsZero reports whether x is the zero value of AnnotationUrgency or its default value, which marshals as null in JSON.
+// It also makes the `omitzero` option of encoding/json omit the field.
+func (x AnnotationUrgency) IsZero() bool {
+	return x == AnnotationUrgency(0) || x == AnnotationUrgencyNormal
+}
+
+// MarshalJSON implements the json.Marshaler interface, writing the name of the value.
+func (x AnnotationUrgency) MarshalJSON() ([]byte, error) {
+	if x.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(x.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, accepting the name of a value.
+func (x *AnnotationUrgency) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var name string
+	if err := json.Unmarshal(b, &name); err != nil {
+		return fmt.Errorf("%s is %w", b, ErrInvalidAnnotationUrgency)
+	}
+	tmp, err := ParseAnnotationUrgency(name)
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+const (
+	// AnnotationUserGuest is a AnnotationUser of type Guest.
+	AnnotationUserGuest AnnotationUser = iota
+	// AnnotationUserActive is a AnnotationUser of type Active.
+	// user is active
+	AnnotationUserActive
+	// AnnotationUserBanned is a AnnotationUser of type Banned.
+	// user is banned
+	AnnotationUserBanned
+)
+
+var ErrInvalidAnnotationUser = errors.New("not a valid AnnotationUser")
+
+const _AnnotationUserName = "guestactivebanned"
+
+var _AnnotationUserMap = map[AnnotationUser]string{
+	AnnotationUserGuest:  _AnnotationUserName[0:5],
+	AnnotationUserActive: _AnnotationUserName[5:11],
+	AnnotationUserBanned: _AnnotationUserName[11:17],
+}
+
+// String implements the Stringer interface.
+func (x AnnotationUser) String() string {
+	if str, ok := _AnnotationUserMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("AnnotationUser(%d)", x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x AnnotationUser) IsValid() bool {
+	_, ok := _AnnotationUserMap[x]
+	return ok
+}
+
+var _AnnotationUserValue = map[string]AnnotationUser{
+	_AnnotationUserName[0:5]:   AnnotationUserGuest,
+	_AnnotationUserName[5:11]:  AnnotationUserActive,
+	_AnnotationUserName[11:17]: AnnotationUserBanned,
+}
+
+// ParseAnnotationUser attempts to convert a string to a AnnotationUser.
+func ParseAnnotationUser(name string) (AnnotationUser, error) {
+	if x, ok := _AnnotationUserValue[name]; ok {
 		return x, nil
 	}
-	return AnnotationColor(""), fmt.Errorf("%s is %w", name, ErrInvalidAnnotationColor)
+	return AnnotationUser(0), fmt.Errorf("%s is %w", name, ErrInvalidAnnotationUser)
+}
+
+// Description returns the inline comment given to x in the ENUM declaration, or an empty string.
+func (x AnnotationUser) Description() string {
+	switch x {
+	case AnnotationUserActive:
+		return "user is active"
+	case AnnotationUserBanned:
+		return "user is banned"
+	}
+	return ""
 }
 
 const (
-	// AnnotationNumberOne is a AnnotationNumber of type One.
-	AnnotationNumberOne AnnotationNumber = iota
-	// AnnotationNumberTwo is a AnnotationNumber of type Two.
-	AnnotationNumberTwo
-	// AnnotationNumberThree is a AnnotationNumber of type Three.
-	AnnotationNumberThree
+	// AnnotationWeightLight is a AnnotationWeight of type Light.
+	AnnotationWeightLight AnnotationWeight = iota
+	// AnnotationWeightHeavy is a AnnotationWeight of type Heavy.
+	AnnotationWeightHeavy
 )
 
-var ErrInvalidAnnotationNumber = errors.New("not a valid AnnotationNumber")
+var ErrInvalidAnnotationWeight = errors.New("not a valid AnnotationWeight")
 
-const _AnnotationNumberName = "onetwothree"
+const _AnnotationWeightName = "lightheavy"
 
-var _AnnotationNumberMap = map[AnnotationNumber]string{
-	AnnotationNumberOne:   _AnnotationNumberName[0:3],
-	AnnotationNumberTwo:   _AnnotationNumberName[3:6],
-	AnnotationNumberThree: _AnnotationNumberName[6:11],
+var _AnnotationWeightMap = map[AnnotationWeight]string{
+	AnnotationWeightLight: _AnnotationWeightName[0:5],
+	AnnotationWeightHeavy: _AnnotationWeightName[5:10],
 }
 
 // String implements the Stringer interface.
-func (x AnnotationNumber) String() string {
-	if str, ok := _AnnotationNumberMap[x]; ok {
+func (x AnnotationWeight) String() string {
+	if str, ok := _AnnotationWeightMap[x]; ok {
 		return str
 	}
-	return fmt.Sprintf("AnnotationNumber(%d)", x)
+	return fmt.Sprintf("AnnotationWeight(%d)", x)
 }
 
 // IsValid provides a quick way to determine if the typed value is
 // part of the allowed enumerated values
-func (x AnnotationNumber) IsValid() bool {
-	_, ok := _AnnotationNumberMap[x]
+func (x AnnotationWeight) IsValid() bool {
+	_, ok := _AnnotationWeightMap[x]
 	return ok
 }
 
-var _AnnotationNumberValue = map[string]AnnotationNumber{
-	_AnnotationNumberName[0:3]:  AnnotationNumberOne,
-	_AnnotationNumberName[3:6]:  AnnotationNumberTwo,
-	_AnnotationNumberName[6:11]: AnnotationNumberThree,
+var _AnnotationWeightValue = map[string]AnnotationWeight{
+	_AnnotationWeightName[0:5]:  AnnotationWeightLight,
+	_AnnotationWeightName[5:10]: AnnotationWeightHeavy,
 }
 
-// ParseAnnotationNumber attempts to convert a string to a AnnotationNumber.
-func ParseAnnotationNumber(name string) (AnnotationNumber, error) {
-	if x, ok := _AnnotationNumberValue[name]; ok {
+// ParseAnnotationWeight attempts to convert a string to a AnnotationWeight.
+func ParseAnnotationWeight(name string) (AnnotationWeight, error) {
+	if x, ok := _AnnotationWeightValue[name]; ok {
 		return x, nil
 	}
-	return AnnotationNumber(0), fmt.Errorf("%s is %w", name, ErrInvalidAnnotationNumber)
+	return AnnotationWeight(0), fmt.Errorf("%s is %w", name, ErrInvalidAnnotationWeight)
 }
 
 // MarshalText implements the text marshaller method.
-func (x AnnotationNumber) MarshalText() ([]byte, error) {
-	return []byte(x.String()), nil
+func (x AnnotationWeight) MarshalText() ([]byte, error) {
+	return x.AppendText(nil)
 }
 
 // UnmarshalText implements the text unmarshaller method.
-func (x *AnnotationNumber) UnmarshalText(text []byte) error {
+func (x *AnnotationWeight) UnmarshalText(text []byte) error {
 	name := string(text)
-	tmp, err := ParseAnnotationNumber(name)
+	tmp, err := ParseAnnotationWeight(name)
 	if err != nil {
 		return err
 	}
@@ -125,142 +3644,283 @@ func (x *AnnotationNumber) UnmarshalText(text []byte) error {
 // (allocating a larger slice if necessary) and returns the updated slice.
 //
 // Implementations must not retain b, nor mutate any bytes within b[:len(b)].
-func (x *AnnotationNumber) AppendText(b []byte) ([]byte, error) {
+func (x AnnotationWeight) AppendText(b []byte) ([]byte, error) {
 	return append(b, x.String()...), nil
 }
 
-var errAnnotationNumberNilPtr = errors.New("value pointer is nil") // one per type for package clashes
+// MarshalBinary implements the encoding.BinaryMarshaler interface, encoding the value as a varint.
+func (x AnnotationWeight) MarshalBinary() ([]byte, error) {
+	return x.AppendBinary(nil)
+}
+
+// AppendBinary implements the encoding.BinaryAppender interface.
+func (x AnnotationWeight) AppendBinary(b []byte) ([]byte, error) {
+	return binary.AppendVarint(b, int64(x)), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+func (x *AnnotationWeight) UnmarshalBinary(data []byte) error {
+	v, n := binary.Varint(data)
+	if n <= 0 || n != len(data) {
+		return fmt.Errorf("invalid binary data for AnnotationWeight")
+	}
+	tmp := AnnotationWeight(v)
+	if int64(tmp) != v || !tmp.IsValid() {
+		return fmt.Errorf("%d is %w", v, ErrInvalidAnnotationWeight)
+	}
+	*x = tmp
+	return nil
+}
+
+// Compile time assertions that AnnotationWeight implements the interfaces of its generated methods,
+// so dropping an annotation or a hand-written method that other code relies on fails to build.
+var (
+	_ fmt.Stringer               = (*AnnotationWeight)(nil)
+	_ encoding.TextMarshaler     = (*AnnotationWeight)(nil)
+	_ encoding.TextUnmarshaler   = (*AnnotationWeight)(nil)
+	_ encoding.TextAppender      = (*AnnotationWeight)(nil)
+	_ encoding.BinaryMarshaler   = (*AnnotationWeight)(nil)
+	_ encoding.BinaryUnmarshaler = (*AnnotationWeight)(nil)
+	_ encoding.BinaryAppender    = (*AnnotationWeight)(nil)
+	_ sql.Scanner                = (*AnnotationWeight)(nil)
+	_ driver.Valuer              = (*AnnotationWeight)(nil)
+	_ flag.Value                 = (*AnnotationWeight)(nil)
+)
+
+var errAnnotationWeightNilPtr = errors.New("value pointer is nil") // one per type for package clashes
 
 // Scan implements the Scanner interface.
-func (x *AnnotationNumber) Scan(value interface{}) (err error) {
+func (x *AnnotationWeight) Scan(value interface{}) (err error) {
 	if value == nil {
-		*x = AnnotationNumber(0)
+		*x = AnnotationWeight(0)
 		return
 	}
 
+	// sql.RawBytes is a distinct type, treat it like any other []byte.
+	if raw, ok := value.(sql.RawBytes); ok {
+		value = []byte(raw)
+	}
+
 	// A wider range of scannable types.
 	// driver.Value values at the top of the list for expediency
 	switch v := value.(type) {
 	case int64:
-		*x = AnnotationNumber(v)
+		*x, err = _AnnotationWeightFromInt64(v)
 	case string:
-		*x, err = ParseAnnotationNumber(v)
+		*x, err = ParseAnnotationWeight(v)
+		if err != nil {
+			// try parsing the integer value as a string
+			if val, verr := strconv.Atoi(v); verr == nil {
+				*x, err = _AnnotationWeightFromInt64(int64(val))
+			}
+		}
 	case []byte:
-		*x, err = ParseAnnotationNumber(string(v))
-	case AnnotationNumber:
+		*x, err = ParseAnnotationWeight(string(v))
+		if err != nil {
+			// try parsing the integer value as a string
+			if val, verr := strconv.Atoi(string(v)); verr == nil {
+				*x, err = _AnnotationWeightFromInt64(int64(val))
+			}
+		}
+	case AnnotationWeight:
+		// An already typed value is assigned as is, once it is known to be declared
+		if !v.IsValid() {
+			return fmt.Errorf("%d is %w", v, ErrInvalidAnnotationWeight)
+		}
 		*x = v
-	case int:
-		*x = AnnotationNumber(v)
-	case *AnnotationNumber:
+	case *AnnotationWeight:
 		if v == nil {
-			return errAnnotationNumberNilPtr
+			return errAnnotationWeightNilPtr
+		}
+		if !v.IsValid() {
+			return fmt.Errorf("%d is %w", *v, ErrInvalidAnnotationWeight)
 		}
 		*x = *v
+	case int:
+		*x, err = _AnnotationWeightFromInt64(int64(v))
+	case int8:
+		*x, err = _AnnotationWeightFromInt64(int64(v))
+	case int16:
+		*x, err = _AnnotationWeightFromInt64(int64(v))
+	case int32:
+		*x, err = _AnnotationWeightFromInt64(int64(v))
 	case uint:
-		*x = AnnotationNumber(v)
+		*x, err = _AnnotationWeightFromUint64(uint64(v))
+	case uint8:
+		*x, err = _AnnotationWeightFromUint64(uint64(v))
+	case uint16:
+		*x, err = _AnnotationWeightFromUint64(uint64(v))
+	case uint32:
+		*x, err = _AnnotationWeightFromUint64(uint64(v))
 	case uint64:
-		*x = AnnotationNumber(v)
+		*x, err = _AnnotationWeightFromUint64(v)
 	case *int:
 		if v == nil {
-			return errAnnotationNumberNilPtr
+			return errAnnotationWeightNilPtr
 		}
-		*x = AnnotationNumber(*v)
+		*x, err = _AnnotationWeightFromInt64(int64(*v))
 	case *int64:
 		if v == nil {
-			return errAnnotationNumberNilPtr
+			return errAnnotationWeightNilPtr
 		}
-		*x = AnnotationNumber(*v)
+		*x, err = _AnnotationWeightFromInt64(*v)
 	case float64: // json marshals everything as a float64 if it's a number
-		*x = AnnotationNumber(v)
+		*x, err = _AnnotationWeightFromFloat64(v)
 	case *float64: // json marshals everything as a float64 if it's a number
 		if v == nil {
-			return errAnnotationNumberNilPtr
+			return errAnnotationWeightNilPtr
 		}
-		*x = AnnotationNumber(*v)
+		*x, err = _AnnotationWeightFromFloat64(*v)
 	case *uint:
 		if v == nil {
-			return errAnnotationNumberNilPtr
+			return errAnnotationWeightNilPtr
 		}
-		*x = AnnotationNumber(*v)
+		*x, err = _AnnotationWeightFromUint64(uint64(*v))
 	case *uint64:
 		if v == nil {
-			return errAnnotationNumberNilPtr
+			return errAnnotationWeightNilPtr
 		}
-		*x = AnnotationNumber(*v)
+		*x, err = _AnnotationWeightFromUint64(*v)
 	case *string:
 		if v == nil {
-			return errAnnotationNumberNilPtr
+			return errAnnotationWeightNilPtr
 		}
-		*x, err = ParseAnnotationNumber(*v)
+		*x, err = ParseAnnotationWeight(*v)
+		if err != nil {
+			// try parsing the integer value as a string
+			if val, verr := strconv.Atoi(*v); verr == nil {
+				*x, err = _AnnotationWeightFromInt64(int64(val))
+			}
+		}
+	default:
+		return fmt.Errorf("invalid type %T for AnnotationWeight", value)
 	}
 
 	return
 }
 
+// _AnnotationWeightFromInt64 converts an integer read from the database, failing when it does not fit in AnnotationWeight
+// or is not one of its values.
+func _AnnotationWeightFromInt64(v int64) (AnnotationWeight, error) {
+	x := AnnotationWeight(v)
+	if int64(x) != v || !x.IsValid() {
+		return AnnotationWeight(0), fmt.Errorf("%d is %w", v, ErrInvalidAnnotationWeight)
+	}
+	return x, nil
+}
+
+// _AnnotationWeightFromUint64 converts an unsigned integer read from the database, like _AnnotationWeightFromInt64.
+func _AnnotationWeightFromUint64(v uint64) (AnnotationWeight, error) {
+	if v > math.MaxInt64 {
+		return AnnotationWeight(0), fmt.Errorf("%d is %w", v, ErrInvalidAnnotationWeight)
+	}
+	return _AnnotationWeightFromInt64(int64(v))
+}
+
+// _AnnotationWeightFromFloat64 converts a number decoded as a float64, like _AnnotationWeightFromInt64, failing when it is not a whole number.
+func _AnnotationWeightFromFloat64(v float64) (AnnotationWeight, error) {
+	if v != math.Trunc(v) || v < math.MinInt64 || v >= math.MaxInt64 {
+		return AnnotationWeight(0), fmt.Errorf("%v is %w", v, ErrInvalidAnnotationWeight)
+	}
+	return _AnnotationWeightFromInt64(int64(v))
+}
+
 // Value implements the driver Valuer interface.
-func (x AnnotationNumber) Value() (driver.Value, error) {
-	return x.String(), nil
+func (x AnnotationWeight) Value() (driver.Value, error) {
+	return int64(x), nil
+}
+
+// Set implements the Golang flag.Value interface func.
+func (x *AnnotationWeight) Set(val string) error {
+	v, err := ParseAnnotationWeight(val)
+	*x = v
+	return err
+}
+
+// Get implements the Golang flag.Getter interface func.
+func (x *AnnotationWeight) Get() interface{} {
+	return *x
+}
+
+// Type implements the github.com/spf13/pFlag Value interface.
+func (x *AnnotationWeight) Type() string {
+	return "AnnotationWeight"
 }
 
 const (
-	// MyAnnotationStatusPending is a AnnotationStatus of type pending.
-	MyAnnotationStatusPending AnnotationStatus = "pending"
-	// MyAnnotationStatusRunning is a AnnotationStatus of type running.
-	MyAnnotationStatusRunning AnnotationStatus = "running"
-	// MyAnnotationStatusCompleted is a AnnotationStatus of type completed.
-	MyAnnotationStatusCompleted AnnotationStatus = "completed"
-	// MyAnnotationStatusFailed is a AnnotationStatus of type failed.
-	MyAnnotationStatusFailed AnnotationStatus = "failed"
+	// ShopShipmentCarrierPost is a ShipmentCarrier of type Post.
+	ShopShipmentCarrierPost ShipmentCarrier = iota
+	// ShopShipmentCarrierCourier is a ShipmentCarrier of type Courier.
+	ShopShipmentCarrierCourier
 )
 
-var ErrInvalidAnnotationStatus = errors.New("not a valid AnnotationStatus")
+var ErrInvalidShipmentCarrier = errors.New("not a valid ShipmentCarrier")
+
+const _ShipmentCarrierName = "postcourier"
+
+var _ShipmentCarrierMap = map[ShipmentCarrier]string{
+	ShopShipmentCarrierPost:    _ShipmentCarrierName[0:4],
+	ShopShipmentCarrierCourier: _ShipmentCarrierName[4:11],
+}
 
 // String implements the Stringer interface.
-func (x AnnotationStatus) String() string {
-	return string(x)
+func (x ShipmentCarrier) String() string {
+	if str, ok := _ShipmentCarrierMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("ShipmentCarrier(%d)", x)
 }
 
 // IsValid provides a quick way to determine if the typed value is
 // part of the allowed enumerated values
-func (x AnnotationStatus) IsValid() bool {
-	_, err := ParseAnnotationStatus(string(x))
-	return err == nil
+func (x ShipmentCarrier) IsValid() bool {
+	_, ok := _ShipmentCarrierMap[x]
+	return ok
 }
 
-var _AnnotationStatusValue = map[string]AnnotationStatus{
-	"pending":   MyAnnotationStatusPending,
-	"running":   MyAnnotationStatusRunning,
-	"completed": MyAnnotationStatusCompleted,
-	"failed":    MyAnnotationStatusFailed,
+var _ShipmentCarrierValue = map[string]ShipmentCarrier{
+	_ShipmentCarrierName[0:4]:  ShopShipmentCarrierPost,
+	_ShipmentCarrierName[4:11]: ShopShipmentCarrierCourier,
 }
 
-// ParseAnnotationStatus attempts to convert a string to a AnnotationStatus.
-func ParseAnnotationStatus(name string) (AnnotationStatus, error) {
-	if x, ok := _AnnotationStatusValue[name]; ok {
+// ParseShipmentCarrier attempts to convert a string to a ShipmentCarrier.
+func ParseShipmentCarrier(name string) (ShipmentCarrier, error) {
+	if x, ok := _ShipmentCarrierValue[name]; ok {
 		return x, nil
 	}
-	return AnnotationStatus(""), fmt.Errorf("%s is %w", name, ErrInvalidAnnotationStatus)
+	return ShipmentCarrier(0), fmt.Errorf("%s is %w", name, ErrInvalidShipmentCarrier)
 }
 
-// MarshalText implements the text marshaller method.
-func (x AnnotationStatus) MarshalText() ([]byte, error) {
-	return []byte(string(x)), nil
+const (
+	// ShopShipmentSpeedStandard is a ShipmentSpeed of type standard.
+	ShopShipmentSpeedStandard ShipmentSpeed = "standard"
+	// ShopShipmentSpeedExpress is a ShipmentSpeed of type express.
+	ShopShipmentSpeedExpress ShipmentSpeed = "express"
+)
+
+var ErrInvalidShipmentSpeed = errors.New("not a valid ShipmentSpeed")
+
+// String implements the Stringer interface.
+func (x ShipmentSpeed) String() string {
+	return string(x)
 }
 
-// UnmarshalText implements the text unmarshaller method.
-func (x *AnnotationStatus) UnmarshalText(text []byte) error {
-	tmp, err := ParseAnnotationStatus(string(text))
-	if err != nil {
-		return err
-	}
-	*x = tmp
-	return nil
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x ShipmentSpeed) IsValid() bool {
+	_, err := ParseShipmentSpeed(string(x))
+	return err == nil
 }
 
-// AppendText appends the textual representation of itself to the end of b
-// (allocating a larger slice if necessary) and returns the updated slice.
-//
-// Implementations must not retain b, nor mutate any bytes within b[:len(b)].
-func (x *AnnotationStatus) AppendText(b []byte) ([]byte, error) {
-	return append(b, x.String()...), nil
+var _ShipmentSpeedValue = map[string]ShipmentSpeed{
+	"standard": ShopShipmentSpeedStandard,
+	"express":  ShopShipmentSpeedExpress,
+}
+
+// ParseShipmentSpeed attempts to convert a string to a ShipmentSpeed.
+func ParseShipmentSpeed(name string) (ShipmentSpeed, error) {
+	if x, ok := _ShipmentSpeedValue[name]; ok {
+		return x, nil
+	}
+	return ShipmentSpeed(""), fmt.Errorf("%s is %w", name, ErrInvalidShipmentSpeed)
 }
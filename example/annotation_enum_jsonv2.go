@@ -0,0 +1,70 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: example
+// Revision: example
+// Build Date: example
+// Built By: example
+
+//go:build goexperiment.jsonv2 && example
+
+package example
+
+import (
+	"encoding/json/jsontext"
+	"fmt"
+)
+
+// MarshalJSONTo implements the json.MarshalerTo interface of encoding/json/v2, writing the name of the value.
+func (x AnnotationNumber) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if !x.IsValid() {
+		return fmt.Errorf("%d is %w", x, ErrInvalidAnnotationNumber)
+	}
+	return enc.WriteToken(jsontext.String(x.String()))
+}
+
+// UnmarshalJSONFrom implements the json.UnmarshalerFrom interface of encoding/json/v2, accepting the name of a value.
+func (x *AnnotationNumber) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	tok, err := dec.ReadToken()
+	if err != nil {
+		return err
+	}
+	switch tok.Kind() {
+	case 'n':
+		return nil
+	case '"':
+		tmp, err := ParseAnnotationNumber(tok.String())
+		if err != nil {
+			return err
+		}
+		*x = tmp
+		return nil
+	}
+	return fmt.Errorf("%s is %w", tok, ErrInvalidAnnotationNumber)
+}
+
+// MarshalJSONTo implements the json.MarshalerTo interface of encoding/json/v2, writing the name of the value.
+func (x AnnotationStatus) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if !x.IsValid() {
+		return fmt.Errorf("%s is %w", string(x), ErrInvalidAnnotationStatus)
+	}
+	return enc.WriteToken(jsontext.String(string(x)))
+}
+
+// UnmarshalJSONFrom implements the json.UnmarshalerFrom interface of encoding/json/v2, accepting the name of a value.
+func (x *AnnotationStatus) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	tok, err := dec.ReadToken()
+	if err != nil {
+		return err
+	}
+	switch tok.Kind() {
+	case 'n':
+		return nil
+	case '"':
+		tmp, err := ParseAnnotationStatus(tok.String())
+		if err != nil {
+			return err
+		}
+		*x = tmp
+		return nil
+	}
+	return fmt.Errorf("%s is %w", tok, ErrInvalidAnnotationStatus)
+}
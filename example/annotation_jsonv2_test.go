@@ -0,0 +1,41 @@
+//go:build example && goexperiment.jsonv2 && go1.27
+// +build example,goexperiment.jsonv2,go1.27
+
+package example
+
+import (
+	"encoding/json/v2"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type annotationJSONV2 struct {
+	Status AnnotationStatus `json:"status"`
+	Number AnnotationNumber `json:"number"`
+}
+
+func TestAnnotationJSONV2(t *testing.T) {
+	b, err := json.Marshal(annotationJSONV2{Status: MyAnnotationStatusRunning, Number: AnnotationNumberThree})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"status":"running","number":"three"}`, string(b))
+
+	var v annotationJSONV2
+	require.NoError(t, json.Unmarshal([]byte(`{"status":"failed","number":"two"}`), &v))
+	assert.Equal(t, annotationJSONV2{Status: MyAnnotationStatusFailed, Number: AnnotationNumberTwo}, v)
+
+	require.NoError(t, json.Unmarshal([]byte(`{"status":null,"number":"one"}`), &v))
+	assert.Equal(t, annotationJSONV2{Status: MyAnnotationStatusFailed, Number: AnnotationNumberOne}, v)
+
+	err = json.Unmarshal([]byte(`{"status":"bogus"}`), &v)
+	assert.ErrorIs(t, err, ErrInvalidAnnotationStatus)
+	// AnnotationNumber is written by name with @jsonstring, numbers are rejected like in encoding/json
+	err = json.Unmarshal([]byte(`{"number":1}`), &v)
+	assert.ErrorIs(t, err, ErrInvalidAnnotationNumber)
+	err = json.Unmarshal([]byte(`{"number":true}`), &v)
+	assert.ErrorIs(t, err, ErrInvalidAnnotationNumber)
+
+	_, err = json.Marshal(annotationJSONV2{Status: "bogus"})
+	assert.ErrorIs(t, err, ErrInvalidAnnotationStatus)
+}
@@ -4,11 +4,30 @@
 package example
 
 import (
+	"bytes"
+	"database/sql"
+	"encoding"
+	"encoding/csv"
+	"encoding/gob"
 	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"math"
+	"math/rand/v2"
+	"reflect"
+	"slices"
+	"strconv"
 	"strings"
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
 )
 
 type annotationTestData struct {
@@ -40,7 +59,7 @@ func TestAnnotationStatus(t *testing.T) {
 
 	_, err = ParseAnnotationStatus("invalid")
 	assert.Error(t, err)
-	assert.Equal(t, "invalid is not a valid AnnotationStatus", err.Error())
+	assert.Equal(t, "invalid is not a valid AnnotationStatus, try [pending, running, completed, failed]", err.Error())
 
 	// Test Marshal/Unmarshal
 	jsonData := `{"status":"pending"}`
@@ -55,13 +74,75 @@ func TestAnnotationStatus(t *testing.T) {
 	assert.NoError(t, err)
 	assert.JSONEq(t, jsonData, string(marshaled))
 
-	// Test AppendText (method has pointer receiver)
+	// Test AppendText
 	status := MyAnnotationStatusPending
 	text, err := status.AppendText(nil)
 	assert.NoError(t, err)
 	assert.Equal(t, "pending", string(text))
 }
 
+func TestAnnotationStatusValues(t *testing.T) {
+	values := AnnotationStatusValues()
+	assert.Len(t, values, 4)
+	assert.Equal(t, []AnnotationStatus{
+		MyAnnotationStatusPending,
+		MyAnnotationStatusRunning,
+		MyAnnotationStatusCompleted,
+		MyAnnotationStatusFailed,
+	}, values)
+}
+
+func TestAnnotationStatusNames(t *testing.T) {
+	names := AnnotationStatusNames()
+	assert.Equal(t, []string{"pending", "running", "completed", "failed"}, names)
+
+	// Names match String() for each value
+	for i, v := range AnnotationStatusValues() {
+		assert.Equal(t, v.String(), names[i])
+	}
+
+	// Mutating the returned slice doesn't affect later calls
+	names[0] = "mutated"
+	assert.Equal(t, "pending", AnnotationStatusNames()[0])
+}
+
+func TestAnnotationStatusMustParse(t *testing.T) {
+	assert.Equal(t, MyAnnotationStatusPending, MustParseAnnotationStatus("pending"))
+	assert.Equal(t, MyAnnotationStatusFailed, MustParseAnnotationStatus("failed"))
+
+	assert.PanicsWithError(t, "invalid is not a valid AnnotationStatus, try [pending, running, completed, failed]", func() {
+		MustParseAnnotationStatus("invalid")
+	})
+}
+
+func TestAnnotationTextInterfaces(t *testing.T) {
+	var (
+		_ encoding.TextMarshaler   = MyAnnotationStatusPending
+		_ encoding.TextAppender    = MyAnnotationStatusPending
+		_ encoding.TextUnmarshaler = (*AnnotationStatus)(nil)
+		_ encoding.TextMarshaler   = AnnotationNumberOne
+		_ encoding.TextAppender    = AnnotationNumberOne
+		_ encoding.TextUnmarshaler = (*AnnotationNumber)(nil)
+	)
+
+	// MarshalText and AppendText share the same output
+	text, err := AnnotationNumberTwo.MarshalText()
+	assert.NoError(t, err)
+	appended, err := AnnotationNumberTwo.AppendText([]byte("number="))
+	assert.NoError(t, err)
+	assert.Equal(t, "two", string(text))
+	assert.Equal(t, "number=two", string(appended))
+
+	// The text interfaces are enough for flag.TextVar
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	var status AnnotationStatus
+	fs.TextVar(&status, "status", MyAnnotationStatusPending, "status to use")
+	assert.Equal(t, MyAnnotationStatusPending, status)
+	err = fs.Parse([]string{"-status=running"})
+	assert.NoError(t, err)
+	assert.Equal(t, MyAnnotationStatusRunning, status)
+}
+
 func TestAnnotationColor(t *testing.T) {
 	// Test noprefix - no "AnnotationColor" prefix
 	assert.Equal(t, AnnotationRed, AnnotationColor("annotation_red"))
@@ -143,13 +224,112 @@ func TestAnnotationNumber(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, "one", val)
 
-	// Test AppendText (method has pointer receiver)
+	// Test AppendText
 	numAppend := AnnotationNumberOne
 	text, err := numAppend.AppendText(nil)
 	assert.NoError(t, err)
 	assert.Equal(t, "one", string(text))
 }
 
+func TestAnnotationLevel(t *testing.T) {
+	// Test String()
+	assert.Equal(t, "debug", AnnotationLevelDebug.String())
+	assert.Equal(t, "error", AnnotationLevelError.String())
+
+	// Test IsValid()
+	assert.True(t, AnnotationLevelInfo.IsValid())
+	assert.False(t, AnnotationLevel("invalid").IsValid())
+
+	// Test YAML Marshal/Unmarshal
+	yamlData := "level: warn\n"
+	var data struct {
+		Level AnnotationLevel `yaml:"level"`
+	}
+	err := yaml.Unmarshal([]byte(yamlData), &data)
+	assert.NoError(t, err)
+	assert.Equal(t, AnnotationLevelWarn, data.Level)
+
+	marshaled, err := yaml.Marshal(data)
+	assert.NoError(t, err)
+	assert.Equal(t, yamlData, string(marshaled))
+
+	// Test nocase is respected by the yaml path
+	err = yaml.Unmarshal([]byte("level: INFO\n"), &data)
+	assert.NoError(t, err)
+	assert.Equal(t, AnnotationLevelInfo, data.Level)
+
+	// Test invalid
+	err = yaml.Unmarshal([]byte("level: invalid\n"), &data)
+	assert.Error(t, err)
+	assert.Equal(t, "invalid is not a valid AnnotationLevel", err.Error())
+}
+
+func TestAnnotationOrderAliases(t *testing.T) {
+	// Both spellings parse to the same constant
+	cancelled, err := ParseAnnotationOrder("cancelled")
+	assert.NoError(t, err)
+	canceled, err := ParseAnnotationOrder("canceled")
+	assert.NoError(t, err)
+	assert.Equal(t, cancelled, canceled)
+	assert.Equal(t, AnnotationOrderCancelled, canceled)
+
+	stopped, err := ParseAnnotationOrder("stopped")
+	assert.NoError(t, err)
+	assert.Equal(t, AnnotationOrderCancelled, stopped)
+
+	// Aliases are not declared values
+	assert.Equal(t, []AnnotationOrder{AnnotationOrderActive, AnnotationOrderCancelled}, AnnotationOrderValues())
+	assert.Equal(t, []string{"active", "cancelled"}, AnnotationOrderNames())
+	assert.False(t, AnnotationOrder("canceled").IsValid())
+	assert.True(t, AnnotationOrder("cancelled").IsValid())
+
+	// Marshaling emits the canonical form
+	var data struct {
+		Order AnnotationOrder `json:"order"`
+	}
+	err = json.Unmarshal([]byte(`{"order":"canceled"}`), &data)
+	assert.NoError(t, err)
+	assert.Equal(t, AnnotationOrderCancelled, data.Order)
+
+	marshaled, err := json.Marshal(data)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"order":"cancelled"}`, string(marshaled))
+}
+
+func TestAnnotationEnvironmentTOML(t *testing.T) {
+	marshaled, err := AnnotationEnvironmentStaging.MarshalTOML()
+	assert.NoError(t, err)
+	assert.Equal(t, `"staging"`, string(marshaled))
+
+	// Round trip the decoded TOML value back into the enum
+	decoded, err := strconv.Unquote(string(marshaled))
+	assert.NoError(t, err)
+	var env AnnotationEnvironment
+	err = env.UnmarshalTOML(decoded)
+	assert.NoError(t, err)
+	assert.Equal(t, AnnotationEnvironmentStaging, env)
+
+	// Non string values are rejected
+	err = env.UnmarshalTOML(int64(1))
+	assert.EqualError(t, err, "invalid type int64 for AnnotationEnvironment, expected a string")
+
+	// Invalid strings return the standard error
+	err = env.UnmarshalTOML("qa")
+	assert.EqualError(t, err, "qa is not a valid AnnotationEnvironment")
+}
+
+func TestAnnotationAccountDeprecated(t *testing.T) {
+	// Deprecated values still parse and print normally
+	parsed, err := ParseAnnotationAccount("legacy")
+	assert.NoError(t, err)
+	assert.Equal(t, AnnotationAccountLegacy, parsed)
+	assert.Equal(t, "legacy", AnnotationAccountLegacy.String())
+	assert.True(t, AnnotationAccountLegacy.IsValid())
+
+	// @hidedeprecated leaves them out of Values()
+	assert.Equal(t, []AnnotationAccount{AnnotationAccountActive, AnnotationAccountArchived}, AnnotationAccountValues())
+}
+
 func TestAnnotationSQL(t *testing.T) {
 	// Test AnnotationNumber SQL (enabled)
 	var num AnnotationNumber
@@ -169,11 +349,79 @@ func TestAnnotationSQL(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, "two", val)
 
+	// Scan from raw bytes, sql.RawBytes included
+	num = AnnotationNumberOne
+	assert.NoError(t, num.Scan([]byte("two")))
+	assert.Equal(t, AnnotationNumberTwo, num)
+	assert.NoError(t, num.Scan(sql.RawBytes("three")))
+	assert.Equal(t, AnnotationNumberThree, num)
+
+	// Unsupported types are rejected and leave the value untouched
+	err = num.Scan(true)
+	assert.EqualError(t, err, "invalid type bool for AnnotationNumber")
+	assert.Equal(t, AnnotationNumberThree, num)
+
+	// NULL resets to the zero value
+	assert.NoError(t, num.Scan(nil))
+	assert.Equal(t, AnnotationNumber(0), num)
+
+	var plan AnnotationPlan
+	assert.NoError(t, plan.Scan(sql.RawBytes("pro")))
+	assert.Equal(t, AnnotationPlanPro, plan)
+	assert.EqualError(t, plan.Scan(1.5), "invalid type float64 for AnnotationPlan")
+	assert.Equal(t, AnnotationPlanPro, plan)
+
 	// Test AnnotationStatus SQL (disabled - should not have Scan/Value methods)
 	// We can't test absence directly, but we can verify that the type doesn't implement
 	// driver.Valuer and sql.Scanner for AnnotationStatus (they're not generated)
 }
 
+func TestAnnotationSQLInt(t *testing.T) {
+	// Value stores the number instead of the name
+	val, err := AnnotationRankOne.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), val)
+
+	tests := map[string]struct {
+		input    interface{}
+		expected AnnotationRank
+	}{
+		"int64":         {input: int64(2), expected: AnnotationRankTwo},
+		"int":           {input: 3, expected: AnnotationRankThree},
+		"int8":          {input: int8(1), expected: AnnotationRankOne},
+		"int16":         {input: int16(2), expected: AnnotationRankTwo},
+		"int32":         {input: int32(3), expected: AnnotationRankThree},
+		"uint8":         {input: uint8(1), expected: AnnotationRankOne},
+		"uint16":        {input: uint16(2), expected: AnnotationRankTwo},
+		"uint32":        {input: uint32(3), expected: AnnotationRankThree},
+		"uint64":        {input: uint64(1), expected: AnnotationRankOne},
+		"numeric bytes": {input: []byte("2"), expected: AnnotationRankTwo},
+		"numeric text":  {input: "3", expected: AnnotationRankThree},
+		"name":          {input: "one", expected: AnnotationRankOne},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			var x AnnotationRank
+			assert.NoError(t, x.Scan(tc.input))
+			assert.Equal(t, tc.expected, x)
+		})
+	}
+
+	// Numbers outside of the declared values are rejected, however they are stored
+	for name, input := range map[string]interface{}{
+		"int16":        int16(0),
+		"int32":        int32(4),
+		"int64":        int64(-1),
+		"uint64":       uint64(1 << 63),
+		"numeric text": "42",
+	} {
+		t.Run("invalid "+name, func(t *testing.T) {
+			var x AnnotationRank
+			assert.ErrorIs(t, x.Scan(input), ErrInvalidAnnotationRank)
+		})
+	}
+}
+
 func TestAnnotationMarshalCombined(t *testing.T) {
 	// Test all three together
 	jsonData := `{"status":"completed","color":"annotation_green","number":"three"}`
@@ -189,6 +437,35 @@ func TestAnnotationMarshalCombined(t *testing.T) {
 	assert.JSONEq(t, jsonData, string(marshaled))
 }
 
+func TestAnnotationXMLCombined(t *testing.T) {
+	type xmlTestData struct {
+		XMLName xml.Name         `xml:"task"`
+		Status  AnnotationStatus `xml:"status,attr"`
+		Number  AnnotationNumber `xml:"number"`
+	}
+
+	xmlData := `<task status="running"><number>two</number></task>`
+	var data xmlTestData
+	err := xml.Unmarshal([]byte(xmlData), &data)
+	assert.NoError(t, err)
+	assert.Equal(t, MyAnnotationStatusRunning, data.Status)
+	assert.Equal(t, AnnotationNumberTwo, data.Number)
+
+	marshaled, err := xml.Marshal(data)
+	assert.NoError(t, err)
+	assert.Equal(t, xmlData, string(marshaled))
+
+	// Test invalid element
+	err = xml.Unmarshal([]byte(`<task status="running"><number>four</number></task>`), &data)
+	assert.Error(t, err)
+	assert.Equal(t, "four is not a valid AnnotationNumber", err.Error())
+
+	// Test invalid attribute
+	err = xml.Unmarshal([]byte(`<task status="unknown"><number>one</number></task>`), &data)
+	assert.Error(t, err)
+	assert.Equal(t, "unknown is not a valid AnnotationStatus, try [pending, running, completed, failed]", err.Error())
+}
+
 func BenchmarkAnnotationParse(b *testing.B) {
 	knownItems := []string{
 		"pending",
@@ -216,3 +493,1200 @@ func BenchmarkAnnotationParse(b *testing.B) {
 		})
 	}
 }
+
+func TestAnnotationStageCaseInsensitive(t *testing.T) {
+	for _, input := range []string{"InProgress", "INPROGRESS", "inprogress", "inProgress"} {
+		parsed, err := ParseAnnotationStage(input)
+		assert.NoError(t, err, input)
+		assert.Equal(t, AnnotationStageInProgress, parsed)
+	}
+	assert.Equal(t, "InProgress", AnnotationStageInProgress.String())
+	assert.Equal(t, "OnHold", AnnotationStageOnHold.String())
+
+	_, err := ParseAnnotationStage("in-progress")
+	assert.ErrorIs(t, err, ErrInvalidAnnotationStage)
+}
+
+func TestAnnotationScanCaseInsensitive(t *testing.T) {
+	// Scan goes through Parse, so @nocase columns may hold any case
+	var color AnnotationColor
+	require.NoError(t, color.Scan("ANNOTATION_GREEN"))
+	assert.Equal(t, AnnotationGreen, color)
+	require.NoError(t, color.Scan(sql.RawBytes("Annotation_Blue")))
+	assert.Equal(t, AnnotationBlue, color)
+
+	var stage AnnotationStage
+	require.NoError(t, stage.Scan("ONHOLD"))
+	assert.Equal(t, AnnotationStageOnHold, stage)
+	require.NoError(t, stage.Scan([]byte("done")))
+	assert.Equal(t, AnnotationStageDone, stage)
+	assert.ErrorIs(t, stage.Scan("on-hold"), ErrInvalidAnnotationStage)
+}
+
+func TestAnnotationScanNumbers(t *testing.T) {
+	// Numbers are checked against the declared values, whatever type the driver returns
+	var stage AnnotationStage
+	require.NoError(t, stage.Scan(int64(1)))
+	assert.Equal(t, AnnotationStageOnHold, stage)
+	require.NoError(t, stage.Scan(float64(2)))
+	assert.Equal(t, AnnotationStageDone, stage)
+	done := float64(2)
+	require.NoError(t, stage.Scan(&done))
+	assert.Equal(t, AnnotationStageDone, stage)
+
+	seven := float64(7)
+	for name, input := range map[string]interface{}{
+		"int64":       int64(7),
+		"int16":       int16(-1),
+		"uint32":      uint32(3),
+		"float64":     float64(7),
+		"fraction":    1.5,
+		"huge":        1e300,
+		"nan":         math.NaN(),
+		"*float64":    &seven,
+		"numeric str": "7",
+	} {
+		t.Run(name, func(t *testing.T) {
+			var x AnnotationStage
+			assert.ErrorIs(t, x.Scan(input), ErrInvalidAnnotationStage)
+		})
+	}
+}
+
+func TestAnnotationStatusAll(t *testing.T) {
+	var all []AnnotationStatus
+	for x := range AnnotationStatusAll() {
+		all = append(all, x)
+	}
+	assert.Equal(t, AnnotationStatusValues(), all)
+
+	var numbers []AnnotationNumber
+	for x := range AnnotationNumberAll() {
+		numbers = append(numbers, x)
+	}
+	assert.Equal(t, []AnnotationNumber{AnnotationNumberOne, AnnotationNumberTwo, AnnotationNumberThree}, numbers)
+}
+
+func TestAnnotationStatusAllBreak(t *testing.T) {
+	var all []AnnotationStatus
+	for x := range AnnotationStatusAll() {
+		all = append(all, x)
+		break
+	}
+	assert.Equal(t, []AnnotationStatus{MyAnnotationStatusPending}, all)
+}
+
+func TestAnnotationStatusNextPrev(t *testing.T) {
+	assert.Equal(t, MyAnnotationStatusRunning, MyAnnotationStatusPending.Next())
+	assert.Equal(t, MyAnnotationStatusPending, MyAnnotationStatusFailed.Next())
+	assert.Equal(t, MyAnnotationStatusFailed, MyAnnotationStatusPending.Prev())
+	assert.Equal(t, MyAnnotationStatusCompleted, MyAnnotationStatusFailed.Prev())
+}
+
+func TestAnnotationStatusOrdinal(t *testing.T) {
+	// Ordinals follow the source order rather than the alphabetical order of the strings
+	for i, x := range []AnnotationStatus{MyAnnotationStatusPending, MyAnnotationStatusRunning, MyAnnotationStatusCompleted} {
+		assert.Equal(t, i, x.Ordinal())
+	}
+	assert.Equal(t, 3, MyAnnotationStatusFailed.Ordinal())
+	assert.Equal(t, -1, AnnotationStatus("sleeping").Ordinal())
+}
+
+func TestAnnotationCompare(t *testing.T) {
+	statuses := []AnnotationStatus{MyAnnotationStatusFailed, MyAnnotationStatusPending, MyAnnotationStatusCompleted, MyAnnotationStatusRunning}
+	slices.SortFunc(statuses, AnnotationStatus.Compare)
+	assert.Equal(t, []AnnotationStatus{MyAnnotationStatusPending, MyAnnotationStatusRunning, MyAnnotationStatusCompleted, MyAnnotationStatusFailed}, statuses)
+
+	assert.Equal(t, 0, MyAnnotationStatusRunning.Compare(MyAnnotationStatusRunning))
+	assert.Equal(t, -1, AnnotationStatus("sleeping").Compare(MyAnnotationStatusPending))
+
+	codes := []HTTPStatus{HTTPStatusError, HTTPStatusOK, HTTPStatusNotFound, HTTPStatusCreated}
+	slices.SortFunc(codes, HTTPStatus.Compare)
+	assert.Equal(t, []HTTPStatus{HTTPStatusOK, HTTPStatusCreated, HTTPStatusNotFound, HTTPStatusError}, codes)
+}
+
+func TestAnnotationStatusIndex(t *testing.T) {
+	assert.Equal(t, 2, MyAnnotationStatusCompleted.Index())
+
+	x, err := AnnotationStatusFromIndex(3)
+	assert.NoError(t, err)
+	assert.Equal(t, MyAnnotationStatusFailed, x)
+
+	_, err = AnnotationStatusFromIndex(4)
+	assert.ErrorIs(t, err, ErrInvalidAnnotationStatus)
+	assert.EqualError(t, err, "index 4 is not a valid AnnotationStatus, try [pending, running, completed, failed]")
+}
+
+func TestAnnotationNullPlan(t *testing.T) {
+	var x NullAnnotationPlan
+	assert.NoError(t, x.Scan("pro"))
+	assert.True(t, x.Valid)
+	assert.Equal(t, AnnotationPlanPro, x.AnnotationPlan)
+
+	v, err := x.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "pro", v)
+
+	assert.NoError(t, x.Scan(nil))
+	assert.False(t, x.Valid)
+	v, err = x.Value()
+	assert.NoError(t, err)
+	assert.Nil(t, v)
+
+	x = NewNullAnnotationPlan(AnnotationPlanEnterprise)
+	assert.True(t, x.Valid)
+	assert.Equal(t, AnnotationPlanEnterprise, x.AnnotationPlan)
+}
+
+func TestAnnotationNullTier(t *testing.T) {
+	var x NullAnnotationTier
+	assert.NoError(t, x.Scan(int64(2)))
+	assert.True(t, x.Valid)
+	assert.Equal(t, AnnotationTierGold, x.AnnotationTier)
+
+	v, err := x.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), v)
+
+	assert.NoError(t, x.Scan(nil))
+	assert.False(t, x.Valid)
+	v, err = x.Value()
+	assert.NoError(t, err)
+	assert.Nil(t, v)
+
+	x = NewNullAnnotationTier(AnnotationTierSilver)
+	assert.True(t, x.Valid)
+	assert.Equal(t, AnnotationTierSilver, x.AnnotationTier)
+}
+
+func TestAnnotationSuffix(t *testing.T) {
+	assert.Equal(t, AnnotationChannel("email"), AnnotationChannelEmailEnum)
+	assert.Equal(t, "sms", AnnotationChannelSmsEnum.String())
+	parsed, err := ParseAnnotationChannel("sms")
+	assert.NoError(t, err)
+	assert.Equal(t, AnnotationChannelSmsEnum, parsed)
+
+	assert.Equal(t, AnnotationSignal(1), GoSignal)
+	assert.Equal(t, "stop", StopSignal.String())
+	signal, err := ParseAnnotationSignal("go")
+	assert.NoError(t, err)
+	assert.Equal(t, GoSignal, signal)
+}
+
+func TestAnnotationMarshalNumeric(t *testing.T) {
+	b, err := json.Marshal(AnnotationCodeOk)
+	assert.NoError(t, err)
+	assert.Equal(t, "1", string(b))
+
+	b, err = json.Marshal(map[string]AnnotationCode{"code": AnnotationCodeFatal})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"code":9}`, string(b))
+
+	var x AnnotationCode
+	assert.NoError(t, json.Unmarshal([]byte("2"), &x))
+	assert.Equal(t, AnnotationCodeRetry, x)
+
+	err = json.Unmarshal([]byte("999"), &x)
+	assert.ErrorIs(t, err, ErrInvalidAnnotationCode)
+	assert.EqualError(t, err, "999 is not a valid AnnotationCode")
+	assert.Equal(t, AnnotationCodeRetry, x)
+
+	// null leaves the value alone, as encoding/json does for the other types
+	assert.NoError(t, json.Unmarshal([]byte("null"), &x))
+	assert.Equal(t, AnnotationCodeRetry, x)
+	holder := struct{ Code AnnotationCode }{Code: AnnotationCodeFatal}
+	assert.NoError(t, json.Unmarshal([]byte(`{"Code":null}`), &holder))
+	assert.Equal(t, AnnotationCodeFatal, holder.Code)
+
+	assert.Error(t, json.Unmarshal([]byte(`"retry"`), &x))
+}
+
+func TestAnnotationEnvironmentGraphQL(t *testing.T) {
+	var buf bytes.Buffer
+	AnnotationEnvironmentStaging.MarshalGQL(&buf)
+	assert.Equal(t, `"staging"`, buf.String())
+
+	var x AnnotationEnvironment
+	assert.NoError(t, x.UnmarshalGQL("production"))
+	assert.Equal(t, AnnotationEnvironmentProduction, x)
+
+	err := x.UnmarshalGQL("qa")
+	assert.ErrorIs(t, err, ErrInvalidAnnotationEnvironment)
+	assert.EqualError(t, x.UnmarshalGQL(3), "invalid type int for AnnotationEnvironment, expected a string")
+	assert.Equal(t, AnnotationEnvironmentProduction, x)
+}
+
+func TestAnnotationBinaryGob(t *testing.T) {
+	type cached struct {
+		Level AnnotationLevel
+		Code  AnnotationCode
+	}
+	in := cached{Level: AnnotationLevelWarn, Code: AnnotationCodeFatal}
+
+	var buf bytes.Buffer
+	require.NoError(t, gob.NewEncoder(&buf).Encode(in))
+	var out cached
+	require.NoError(t, gob.NewDecoder(&buf).Decode(&out))
+	assert.Equal(t, in, out)
+
+	b, err := AnnotationCodeFatal.MarshalBinary()
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{18}, b)
+
+	var code AnnotationCode
+	assert.ErrorIs(t, code.UnmarshalBinary([]byte{6}), ErrInvalidAnnotationCode)
+	assert.Error(t, code.UnmarshalBinary(nil))
+
+	var level AnnotationLevel
+	assert.ErrorIs(t, level.UnmarshalBinary([]byte("trace")), ErrInvalidAnnotationLevel)
+}
+
+func TestAnnotationAppendBinary(t *testing.T) {
+	var (
+		_ encoding.BinaryAppender = AnnotationCodeOk
+		_ encoding.BinaryAppender = AnnotationLevelDebug
+	)
+
+	// AppendBinary writes the same bytes as MarshalBinary after the existing ones
+	marshaled, err := AnnotationCodeFatal.MarshalBinary()
+	require.NoError(t, err)
+	appended, err := AnnotationCodeFatal.AppendBinary([]byte{0xff})
+	require.NoError(t, err)
+	assert.Equal(t, append([]byte{0xff}, marshaled...), appended)
+
+	marshaled, err = AnnotationLevelWarn.MarshalBinary()
+	require.NoError(t, err)
+	appended, err = AnnotationLevelWarn.AppendBinary([]byte("level="))
+	require.NoError(t, err)
+	assert.Equal(t, "level="+string(marshaled), string(appended))
+}
+
+func TestAnnotationParseErrorIs(t *testing.T) {
+	_, err := ParseAnnotationNumber("four")
+	assert.True(t, errors.Is(err, ErrInvalidAnnotationNumber))
+	assert.False(t, errors.Is(err, ErrInvalidAnnotationStatus))
+	assert.Equal(t, "four is not a valid AnnotationNumber", err.Error())
+
+	// Wrapping the error further keeps the sentinel reachable
+	wrapped := fmt.Errorf("loading config: %w", err)
+	assert.True(t, errors.Is(wrapped, ErrInvalidAnnotationNumber))
+}
+
+func TestAnnotationPtr(t *testing.T) {
+	type message struct {
+		Channel *AnnotationChannel `json:"channel,omitempty"`
+	}
+
+	b, err := json.Marshal(message{Channel: AnnotationChannelSmsEnum.Ptr()})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"channel":"sms"}`, string(b))
+
+	b, err = json.Marshal(message{})
+	assert.NoError(t, err)
+	assert.Equal(t, `{}`, string(b))
+
+	// Every call hands out a new copy
+	x := AnnotationChannelEmailEnum
+	p := x.Ptr()
+	*p = AnnotationChannelSmsEnum
+	assert.Equal(t, AnnotationChannelEmailEnum, x)
+
+	// PtrString doesn't panic on a nil pointer
+	var status *AnnotationStatus
+	assert.Equal(t, "", status.PtrString())
+	assert.Equal(t, "running", MyAnnotationStatusRunning.Ptr().PtrString())
+	var channel *AnnotationChannel
+	assert.Equal(t, "", channel.PtrString())
+	assert.Equal(t, "sms", AnnotationChannelSmsEnum.Ptr().PtrString())
+}
+
+func TestAnnotationStatusFlagVar(t *testing.T) {
+	var _ flag.Getter = (*AnnotationStatus)(nil)
+
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	status := MyAnnotationStatusCompleted
+	fs.Var(&status, "status", "status to use")
+
+	assert.NoError(t, fs.Parse([]string{"-status=pending"}))
+	assert.Equal(t, MyAnnotationStatusPending, status)
+	assert.Equal(t, MyAnnotationStatusPending, fs.Lookup("status").Value.(flag.Getter).Get())
+
+	err := fs.Parse([]string{"-status=sleeping"})
+	assert.ErrorContains(t, err, "sleeping is not a valid AnnotationStatus")
+}
+
+func TestAnnotationStatusList(t *testing.T) {
+	list, err := ParseAnnotationStatusList("pending, running")
+	assert.NoError(t, err)
+	assert.Equal(t, []AnnotationStatus{MyAnnotationStatusPending, MyAnnotationStatusRunning}, list)
+
+	list, err = ParseAnnotationStatusList("")
+	assert.NoError(t, err)
+	assert.NotNil(t, list)
+	assert.Empty(t, list)
+
+	list, err = ParseAnnotationStatusList("pending,sleeping,running")
+	assert.ErrorIs(t, err, ErrInvalidAnnotationStatus)
+	assert.ErrorContains(t, err, "sleeping is not a valid AnnotationStatus")
+	assert.Nil(t, list)
+
+	numbers, err := ParseAnnotationNumberList(" three ,one")
+	assert.NoError(t, err)
+	assert.Equal(t, []AnnotationNumber{AnnotationNumberThree, AnnotationNumberOne}, numbers)
+}
+
+func TestAnnotationJSONSchema(t *testing.T) {
+	schema := AnnotationStatusJSONSchema()
+	assert.Equal(t, "string", schema["type"])
+	var names []string
+	for _, name := range schema["enum"].([]interface{}) {
+		names = append(names, name.(string))
+	}
+	assert.Equal(t, AnnotationStatusNames(), names)
+
+	// Numeric marshalling describes the numbers
+	b, err := json.Marshal(AnnotationCodeJSONSchema())
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"type":"integer","enum":[1,2,9]}`, string(b))
+}
+
+func TestAnnotationZeroDefault(t *testing.T) {
+	assert.Equal(t, AnnotationTicketUnknown, AnnotationTicketDefault)
+
+	x, err := ParseAnnotationTicket("")
+	assert.NoError(t, err)
+	assert.Equal(t, AnnotationTicketUnknown, x)
+
+	_, err = ParseAnnotationTicket("pending")
+	assert.ErrorIs(t, err, ErrInvalidAnnotationTicket)
+
+	// NULL columns scan as the default value
+	ticket := AnnotationTicketOpen
+	assert.NoError(t, ticket.Scan(nil))
+	assert.Equal(t, AnnotationTicketUnknown, ticket)
+
+	// The empty string is not one of the values unless @zerovalid is set
+	assert.False(t, AnnotationTicket("").IsValid())
+	assert.True(t, AnnotationTicketUnknown.IsValid())
+}
+
+func TestAnnotationZeroValid(t *testing.T) {
+	assert.Equal(t, AnnotationUrgencyNormal, AnnotationUrgencyDefault)
+
+	x, err := ParseAnnotationUrgency("")
+	assert.NoError(t, err)
+	assert.Equal(t, AnnotationUrgencyNormal, x)
+
+	assert.True(t, AnnotationUrgency(0).IsValid())
+	assert.True(t, AnnotationUrgencyHigh.IsValid())
+	assert.False(t, AnnotationUrgency(4).IsValid())
+}
+
+func TestAnnotationCBOR(t *testing.T) {
+	b, err := AnnotationNumberThree.MarshalCBOR()
+	require.NoError(t, err)
+	assert.Equal(t, []byte{0x02}, b)
+
+	var num AnnotationNumber
+	require.NoError(t, num.UnmarshalCBOR(b))
+	assert.Equal(t, AnnotationNumberThree, num)
+
+	// A one byte argument is still a valid encoding of the same integer
+	require.NoError(t, num.UnmarshalCBOR([]byte{0x18, 0x01}))
+	assert.Equal(t, AnnotationNumberTwo, num)
+
+	assert.ErrorIs(t, num.UnmarshalCBOR([]byte{0x05}), ErrInvalidAnnotationNumber)
+	assert.ErrorIs(t, num.UnmarshalCBOR([]byte{0x20}), ErrInvalidAnnotationNumber)
+	assert.Error(t, num.UnmarshalCBOR([]byte{0x61, 'a'}))
+	assert.ErrorIs(t, num.UnmarshalCBOR([]byte{0x18}), io.ErrUnexpectedEOF)
+	assert.Equal(t, AnnotationNumberTwo, num)
+
+	b, err = AnnotationChannelEmailEnum.MarshalCBOR()
+	require.NoError(t, err)
+	assert.Equal(t, []byte{0x65, 'e', 'm', 'a', 'i', 'l'}, b)
+
+	var channel AnnotationChannel
+	require.NoError(t, channel.UnmarshalCBOR(b))
+	assert.Equal(t, AnnotationChannelEmailEnum, channel)
+	assert.ErrorIs(t, channel.UnmarshalCBOR([]byte{0x63, 'f', 'a', 'x'}), ErrInvalidAnnotationChannel)
+	assert.Error(t, channel.UnmarshalCBOR([]byte{0x01}))
+
+	// JSON marshaling is unaffected
+	j, err := json.Marshal(channel)
+	require.NoError(t, err)
+	assert.Equal(t, `"email"`, string(j))
+}
+
+func TestAnnotationMsgpack(t *testing.T) {
+	b, err := AnnotationNumberThree.MarshalMsgpack()
+	require.NoError(t, err)
+	assert.Equal(t, []byte{0x02}, b)
+
+	var num AnnotationNumber
+	require.NoError(t, num.UnmarshalMsgpack(b))
+	assert.Equal(t, AnnotationNumberThree, num)
+
+	// Wider integer formats decode to the same value
+	require.NoError(t, num.UnmarshalMsgpack([]byte{0xcd, 0x00, 0x01}))
+	assert.Equal(t, AnnotationNumberTwo, num)
+	require.NoError(t, num.UnmarshalMsgpack([]byte{0xd0, 0x00}))
+	assert.Equal(t, AnnotationNumberOne, num)
+
+	assert.ErrorIs(t, num.UnmarshalMsgpack([]byte{0x09}), ErrInvalidAnnotationNumber)
+	assert.ErrorIs(t, num.UnmarshalMsgpack([]byte{0xff}), ErrInvalidAnnotationNumber)
+	assert.ErrorIs(t, num.UnmarshalMsgpack([]byte{0xd1, 0xff, 0xfe}), ErrInvalidAnnotationNumber)
+	assert.Error(t, num.UnmarshalMsgpack([]byte{0xa3, 'o', 'n', 'e'}))
+	assert.Error(t, num.UnmarshalMsgpack([]byte{0xcc}))
+	assert.Equal(t, AnnotationNumberOne, num)
+
+	b, err = AnnotationLevelWarn.MarshalMsgpack()
+	require.NoError(t, err)
+	assert.Equal(t, []byte{0xa4, 'w', 'a', 'r', 'n'}, b)
+
+	var level AnnotationLevel
+	require.NoError(t, level.UnmarshalMsgpack(b))
+	assert.Equal(t, AnnotationLevelWarn, level)
+	require.NoError(t, level.UnmarshalMsgpack([]byte{0xd9, 0x04, 'i', 'n', 'f', 'o'}))
+	assert.Equal(t, AnnotationLevelInfo, level)
+
+	assert.ErrorIs(t, level.UnmarshalMsgpack([]byte{0xa5, 't', 'r', 'a', 'c', 'e'}), ErrInvalidAnnotationLevel)
+	assert.Error(t, level.UnmarshalMsgpack([]byte{0xa5, 'w', 'a', 'r', 'n'}))
+	assert.Error(t, level.UnmarshalMsgpack([]byte{0x01}))
+	assert.Equal(t, AnnotationLevelInfo, level)
+}
+
+func TestAnnotationParseOrDefault(t *testing.T) {
+	assert.Equal(t, MyAnnotationStatusRunning, ParseAnnotationStatusOrDefault("running", MyAnnotationStatusPending))
+	assert.Equal(t, MyAnnotationStatusPending, ParseAnnotationStatusOrDefault("sleeping", MyAnnotationStatusPending))
+	assert.Equal(t, MyAnnotationStatusFailed, ParseAnnotationStatusOrDefault("", MyAnnotationStatusFailed))
+
+	allocs := testing.AllocsPerRun(100, func() {
+		ParseAnnotationStatusOrDefault("sleeping", MyAnnotationStatusPending)
+	})
+	assert.Zero(t, allocs)
+}
+
+func TestAnnotationDescriptions(t *testing.T) {
+	assert.Equal(t, "user is active", AnnotationUserActive.Description())
+	assert.Equal(t, "user is banned", AnnotationUserBanned.Description())
+	assert.Equal(t, "", AnnotationUserGuest.Description())
+	assert.Equal(t, "", AnnotationUser(42).Description())
+}
+
+func TestAnnotationMapKeys(t *testing.T) {
+	counts := map[AnnotationStatus]int{MyAnnotationStatusPending: 2, MyAnnotationStatusFailed: 1}
+	b, err := json.Marshal(counts)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"pending":2,"failed":1}`, string(b))
+
+	var decoded map[AnnotationStatus]int
+	require.NoError(t, json.Unmarshal(b, &decoded))
+	assert.Equal(t, counts, decoded)
+
+	// Unknown keys go through UnmarshalText and are rejected
+	err = json.Unmarshal([]byte(`{"sleeping":1}`), &decoded)
+	assert.ErrorIs(t, err, ErrInvalidAnnotationStatus)
+
+	// Int enums use their names rather than their numbers as keys
+	numbers := map[AnnotationNumber]string{AnnotationNumberOne: "first", AnnotationNumberThree: "last"}
+	b, err = json.Marshal(numbers)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"one":"first","three":"last"}`, string(b))
+
+	var decodedNumbers map[AnnotationNumber]string
+	require.NoError(t, json.Unmarshal(b, &decodedNumbers))
+	assert.Equal(t, numbers, decodedNumbers)
+}
+
+func TestAnnotationTrimPrefix(t *testing.T) {
+	assert.Equal(t, "red", AnnotationPaintRed.String())
+	assert.Equal(t, AnnotationPaint("light_blue"), AnnotationPaintLightBlue)
+	x, err := ParseAnnotationPaint("green")
+	require.NoError(t, err)
+	assert.Equal(t, AnnotationPaintGreen, x)
+	_, err = ParseAnnotationPaint("color_green")
+	assert.ErrorIs(t, err, ErrInvalidAnnotationPaint)
+
+	assert.Equal(t, "light", AnnotationShadeLight.String())
+	assert.Equal(t, AnnotationShade(1), AnnotationShadeLight)
+}
+
+func TestAnnotationLenientJSON(t *testing.T) {
+	type job struct {
+		State AnnotationJob `json:"state"`
+	}
+
+	var byName, byNumber job
+	require.NoError(t, json.Unmarshal([]byte(`{"state":"pending"}`), &byName))
+	require.NoError(t, json.Unmarshal([]byte(`{"state":0}`), &byNumber))
+	assert.Equal(t, AnnotationJobPending, byName.State)
+	assert.Equal(t, byName, byNumber)
+
+	require.NoError(t, json.Unmarshal([]byte(`{"state":2}`), &byNumber))
+	assert.Equal(t, AnnotationJobDone, byNumber.State)
+
+	// Marshaling still writes the name
+	b, err := json.Marshal(byNumber)
+	require.NoError(t, err)
+	assert.Equal(t, `{"state":"done"}`, string(b))
+
+	assert.ErrorIs(t, json.Unmarshal([]byte(`{"state":"sleeping"}`), &byName), ErrInvalidAnnotationJob)
+	assert.ErrorIs(t, json.Unmarshal([]byte(`{"state":7}`), &byName), ErrInvalidAnnotationJob)
+	assert.ErrorIs(t, json.Unmarshal([]byte(`{"state":true}`), &byName), ErrInvalidAnnotationJob)
+	assert.Equal(t, AnnotationJobPending, byName.State)
+}
+
+func TestAnnotationGoMap(t *testing.T) {
+	names := AnnotationStatusNameMap()
+	assert.Equal(t, map[string]AnnotationStatus{
+		"pending":   MyAnnotationStatusPending,
+		"running":   MyAnnotationStatusRunning,
+		"completed": MyAnnotationStatusCompleted,
+		"failed":    MyAnnotationStatusFailed,
+	}, names)
+	values := AnnotationStatusValueMap()
+	assert.Len(t, values, 4)
+	assert.Equal(t, "running", values[MyAnnotationStatusRunning])
+
+	// The maps are copies, changing them doesn't affect parsing
+	delete(names, "pending")
+	names["sleeping"] = MyAnnotationStatusFailed
+	_, err := ParseAnnotationStatus("sleeping")
+	assert.ErrorIs(t, err, ErrInvalidAnnotationStatus)
+	x, err := ParseAnnotationStatus("pending")
+	require.NoError(t, err)
+	assert.Equal(t, MyAnnotationStatusPending, x)
+	assert.Len(t, AnnotationStatusNameMap(), 4)
+
+	numbers := AnnotationNumberValueMap()
+	assert.Equal(t, map[AnnotationNumber]string{AnnotationNumberOne: "one", AnnotationNumberTwo: "two", AnnotationNumberThree: "three"}, numbers)
+	assert.Equal(t, AnnotationNumberTwo, AnnotationNumberNameMap()["two"])
+	numbers[AnnotationNumberOne] = "uno"
+	assert.Equal(t, "one", AnnotationNumberOne.String())
+}
+
+func TestAnnotationValidate(t *testing.T) {
+	assert.NoError(t, MyAnnotationStatusRunning.Validate())
+	err := AnnotationStatus("bad").Validate()
+	assert.ErrorIs(t, err, ErrInvalidAnnotationStatus)
+	assert.EqualError(t, err, "bad is not a valid AnnotationStatus, try [pending, running, completed, failed]")
+
+	assert.NoError(t, AnnotationCodeRetry.Validate())
+	assert.EqualError(t, AnnotationCode(5).Validate(), "5 is not a valid AnnotationCode")
+}
+
+func TestAnnotationCase(t *testing.T) {
+	assert.Equal(t, "in-progress", AnnotationPhaseInProgress.String())
+	assert.Equal(t, "on-hold", AnnotationPhaseOnHold.String())
+	x, err := ParseAnnotationPhase("on-hold")
+	require.NoError(t, err)
+	assert.Equal(t, AnnotationPhaseOnHold, x)
+	_, err = ParseAnnotationPhase("OnHold")
+	assert.ErrorIs(t, err, ErrInvalidAnnotationPhase)
+}
+
+func TestAnnotationLazyParse(t *testing.T) {
+	// The first calls race to build the maps, run with -race to check it is safe.
+	var wg sync.WaitGroup
+	for i := 0; i < 16; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			v, err := ParseAnnotationRegion("europe")
+			assert.NoError(t, err)
+			assert.Equal(t, AnnotationRegionEurope, v)
+		}()
+	}
+	wg.Wait()
+
+	v, err := ParseAnnotationRegion("AsiaPacific")
+	assert.NoError(t, err)
+	assert.Equal(t, AnnotationRegionAsiaPacific, v)
+
+	_, err = ParseAnnotationRegion("africa")
+	assert.ErrorIs(t, err, ErrInvalidAnnotationRegion)
+}
+
+func BenchmarkAnnotationLazyParse(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := ParseAnnotationRegion("Europe"); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func TestAnnotationNoPrefixWithPrefix(t *testing.T) {
+	assert.Equal(t, "apple", FruitApple.String())
+	assert.Equal(t, AnnotationFruit(1), FruitPear)
+}
+
+func TestAnnotationFormat(t *testing.T) {
+	assert.Equal(t, "pending 0", fmt.Sprintf("%v %d", AnnotationJobPending, AnnotationJobPending))
+	assert.Equal(t, "[pending done]", fmt.Sprintf("%v", []AnnotationJob{AnnotationJobPending, AnnotationJobDone}))
+	assert.Equal(t, `{Job:running}`, fmt.Sprintf("%+v", struct{ Job AnnotationJob }{AnnotationJobRunning}))
+	assert.Equal(t, `"done" 0x2`, fmt.Sprintf("%q %#x", AnnotationJobDone, AnnotationJobDone))
+	assert.Equal(t, "AnnotationJob(7)", fmt.Sprintf("%s", AnnotationJob(7)))
+
+	assert.Equal(t, "warn 2", fmt.Sprintf("%v %d", AnnotationLevelWarn, AnnotationLevelWarn))
+	assert.Equal(t, "[debug error]", fmt.Sprintf("%v", []AnnotationLevel{AnnotationLevelDebug, AnnotationLevelError}))
+	assert.Equal(t, "-1", fmt.Sprintf("%d", AnnotationLevel("trace")))
+}
+
+func TestAnnotationStrict(t *testing.T) {
+	// The generated assertion block already fails the build when a method is missing,
+	// this checks the values behave through the asserted interfaces.
+	var currency any = new(AnnotationCurrency)
+	unmarshaler, ok := currency.(encoding.TextUnmarshaler)
+	require.True(t, ok)
+	require.NoError(t, unmarshaler.UnmarshalText([]byte("eur")))
+	assert.Equal(t, AnnotationCurrencyEur, *currency.(*AnnotationCurrency))
+
+	var weight any = new(AnnotationWeight)
+	value, ok := weight.(flag.Value)
+	require.True(t, ok)
+	require.NoError(t, value.Set("heavy"))
+	assert.Equal(t, "heavy", value.String())
+}
+
+func TestAnnotationJSONString(t *testing.T) {
+	b, err := AnnotationNumberOne.MarshalJSON()
+	require.NoError(t, err)
+	assert.Equal(t, `"one"`, string(b))
+
+	b, err = json.Marshal(map[string]AnnotationNumber{"n": AnnotationNumberThree})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"n":"three"}`, string(b))
+
+	var x AnnotationNumber
+	require.NoError(t, json.Unmarshal([]byte(`"two"`), &x))
+	assert.Equal(t, AnnotationNumberTwo, x)
+	assert.ErrorIs(t, json.Unmarshal([]byte(`"four"`), &x), ErrInvalidAnnotationNumber)
+	assert.ErrorIs(t, json.Unmarshal([]byte(`2`), &x), ErrInvalidAnnotationNumber)
+}
+
+func TestAnnotationParseBatch(t *testing.T) {
+	inputs := []string{"running", "unknown", "failed", "", "pending"}
+	results, errs := ParseAnnotationStatusBatch(inputs)
+	require.Len(t, results, len(inputs))
+	require.Len(t, errs, len(inputs))
+
+	assert.Equal(t, []AnnotationStatus{MyAnnotationStatusRunning, "", MyAnnotationStatusFailed, "", MyAnnotationStatusPending}, results)
+	for i, err := range errs {
+		if inputs[i] == "unknown" || inputs[i] == "" {
+			assert.ErrorIs(t, err, ErrInvalidAnnotationStatus, inputs[i])
+		} else {
+			assert.NoError(t, err, inputs[i])
+		}
+	}
+
+	results, errs = ParseAnnotationStatusBatch(nil)
+	assert.Empty(t, results)
+	assert.Empty(t, errs)
+}
+
+func TestAnnotationOmitZero(t *testing.T) {
+	type request struct {
+		Urgency AnnotationUrgency `json:"urgency"`
+		Ticket  AnnotationTicket  `json:"ticket"`
+	}
+	b, err := json.Marshal(request{})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"urgency":null,"ticket":null}`, string(b))
+
+	// The default values are treated as empty as well
+	b, err = json.Marshal(request{Urgency: AnnotationUrgencyNormal, Ticket: AnnotationTicketUnknown})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"urgency":null,"ticket":null}`, string(b))
+
+	b, err = json.Marshal(request{Urgency: AnnotationUrgencyHigh, Ticket: AnnotationTicketOpen})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"urgency":"high","ticket":"open"}`, string(b))
+
+	var r request
+	require.NoError(t, json.Unmarshal([]byte(`{"urgency":"low","ticket":null}`), &r))
+	assert.Equal(t, AnnotationUrgencyLow, r.Urgency)
+	assert.Equal(t, AnnotationTicket(""), r.Ticket)
+
+	// The omitzero option of encoding/json drops the fields through IsZero
+	type optional struct {
+		Urgency AnnotationUrgency `json:"urgency,omitzero"`
+		Ticket  AnnotationTicket  `json:"ticket,omitzero"`
+	}
+	b, err = json.Marshal(optional{Urgency: AnnotationUrgencyNormal})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{}`, string(b))
+}
+
+func TestAnnotationRandom(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	seen := map[AnnotationStatus]bool{}
+	for i := 0; i < 1000; i++ {
+		status := RandomAnnotationStatus(r)
+		require.True(t, status.IsValid(), status)
+		seen[status] = true
+
+		require.True(t, RandomAnnotationStatusGlobal().IsValid())
+		require.True(t, RandomAnnotationJob(r).IsValid())
+		require.True(t, RandomAnnotationJobGlobal().IsValid())
+	}
+	assert.Len(t, seen, len(AnnotationStatusValues()), "every value is drawn eventually")
+}
+
+func TestAnnotationGroup(t *testing.T) {
+	assert.Equal(t, "courier", ShopShipmentCarrierCourier.String())
+	assert.Equal(t, ShipmentSpeed("express"), ShopShipmentSpeedExpress)
+
+	speed, err := ParseShipmentSpeed("standard")
+	require.NoError(t, err)
+	assert.Equal(t, ShopShipmentSpeedStandard, speed)
+}
+
+func TestAnnotationPredicates(t *testing.T) {
+	assert.True(t, MyAnnotationStatusPending.IsPending())
+	assert.False(t, MyAnnotationStatusPending.IsRunning())
+	assert.True(t, MyAnnotationStatusFailed.IsFailed())
+	assert.False(t, AnnotationStatus("unknown").IsCompleted())
+}
+
+func TestAnnotationParseBytes(t *testing.T) {
+	parsed, err := ParseAnnotationStatusBytes([]byte("running"))
+	assert.NoError(t, err)
+	assert.Equal(t, MyAnnotationStatusRunning, parsed)
+
+	_, err = ParseAnnotationStatusBytes([]byte("Running"))
+	assert.ErrorIs(t, err, ErrInvalidAnnotationStatus)
+	_, strErr := ParseAnnotationStatus("Running")
+	assert.EqualError(t, err, strErr.Error())
+
+	color, err := ParseAnnotationColorBytes([]byte("Annotation_Green"))
+	assert.NoError(t, err)
+	assert.Equal(t, AnnotationGreen, color)
+
+	_, err = ParseAnnotationColorBytes(nil)
+	assert.ErrorIs(t, err, ErrInvalidAnnotationColor)
+}
+
+func BenchmarkAnnotationParseBytes(b *testing.B) {
+	input := []byte("completed")
+	b.Run("string", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if _, err := ParseAnnotationStatus(string(input)); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("bytes", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if _, err := ParseAnnotationStatusBytes(input); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+func TestAnnotationErrFmt(t *testing.T) {
+	_, err := ParseAnnotationSize("huge")
+	assert.EqualError(t, err, `"huge" n'est pas une valeur de AnnotationSize`)
+	assert.ErrorIs(t, err, ErrInvalidAnnotationSize)
+
+	_, err = ParseAnnotationSizeBytes([]byte("tiny"))
+	assert.EqualError(t, err, `"tiny" n'est pas une valeur de AnnotationSize`)
+
+	parsed, err := ParseAnnotationSize("medium")
+	assert.NoError(t, err)
+	assert.Equal(t, AnnotationSizeMedium, parsed)
+}
+
+func TestAnnotationContains(t *testing.T) {
+	assert.True(t, AnnotationStatusContains(MyAnnotationStatusFailed))
+	assert.False(t, AnnotationStatusContains(AnnotationStatus("unknown")))
+	assert.True(t, AnnotationStatusContainsString("completed"))
+	assert.False(t, AnnotationStatusContainsString("Completed"))
+
+	statuses := []AnnotationStatus{MyAnnotationStatusPending, "bogus", MyAnnotationStatusRunning}
+	assert.Equal(t, 1, slices.IndexFunc(statuses, func(s AnnotationStatus) bool { return !AnnotationStatusContains(s) }))
+}
+
+func TestAnnotationStrictMarshal(t *testing.T) {
+	_, err := json.Marshal(AnnotationStatus("bogus"))
+	assert.ErrorIs(t, err, ErrInvalidAnnotationStatus)
+	_, err = AnnotationStatus("bogus").MarshalText()
+	assert.EqualError(t, err, "bogus is not a valid AnnotationStatus, try [pending, running, completed, failed]")
+
+	_, err = json.Marshal(AnnotationNumber(7))
+	assert.ErrorIs(t, err, ErrInvalidAnnotationNumber)
+	_, err = AnnotationNumber(7).MarshalText()
+	assert.ErrorIs(t, err, ErrInvalidAnnotationNumber)
+
+	b, err := json.Marshal(MyAnnotationStatusRunning)
+	assert.NoError(t, err)
+	assert.Equal(t, `"running"`, string(b))
+}
+
+func TestAnnotationSetLookup(t *testing.T) {
+	assert.True(t, AnnotationStateCompleted.IsValid())
+	assert.True(t, AnnotationState("failed").IsValid())
+	assert.False(t, AnnotationState("Failed").IsValid())
+	assert.False(t, AnnotationState("").IsValid())
+}
+
+func BenchmarkAnnotationSetLookup(b *testing.B) {
+	b.Run("parse", func(b *testing.B) {
+		x := MyAnnotationStatusFailed
+		for i := 0; i < b.N; i++ {
+			if !x.IsValid() {
+				b.Fatal("invalid")
+			}
+		}
+	})
+	b.Run("set", func(b *testing.B) {
+		x := AnnotationStateFailed
+		for i := 0; i < b.N; i++ {
+			if !x.IsValid() {
+				b.Fatal("invalid")
+			}
+		}
+	})
+}
+
+func TestAnnotationPtrHelper(t *testing.T) {
+	p := MyAnnotationStatusRunning.Ptr()
+	require.NotNil(t, p)
+	assert.Equal(t, MyAnnotationStatusRunning, *p)
+
+	v := MyAnnotationStatusFailed
+	q := AnnotationStatusPtr(v)
+	assert.Equal(t, v, *q)
+	*q = MyAnnotationStatusPending
+	assert.Equal(t, MyAnnotationStatusFailed, v)
+
+	b, err := json.Marshal(struct {
+		Status *AnnotationStatus `json:"status,omitempty"`
+	}{AnnotationStatusPtr(MyAnnotationStatusCompleted)})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"status":"completed"}`, string(b))
+}
+
+func TestAnnotationSQLNullGeneric(t *testing.T) {
+	// Scan has a pointer receiver and Value a value receiver, so sql.Null[T] uses both
+	var color sql.Null[AnnotationColor]
+	require.NoError(t, color.Scan("annotation_blue"))
+	assert.Equal(t, sql.Null[AnnotationColor]{V: AnnotationBlue, Valid: true}, color)
+	value, err := color.Value()
+	require.NoError(t, err)
+	assert.Equal(t, "annotation_blue", value)
+
+	require.NoError(t, color.Scan(nil))
+	assert.False(t, color.Valid)
+	value, err = color.Value()
+	require.NoError(t, err)
+	assert.Nil(t, value)
+
+	var number sql.Null[AnnotationNumber]
+	require.NoError(t, number.Scan(int64(2)))
+	assert.Equal(t, sql.Null[AnnotationNumber]{V: AnnotationNumberThree, Valid: true}, number)
+	value, err = number.Value()
+	require.NoError(t, err)
+	assert.Equal(t, "three", value)
+	assert.ErrorIs(t, number.Scan("four"), ErrInvalidAnnotationNumber)
+
+	require.NoError(t, number.Scan(nil))
+	assert.False(t, number.Valid)
+}
+
+func TestAnnotationComment(t *testing.T) {
+	assert.Equal(t, "Lifecycle states of a job", AnnotationStateDoc)
+}
+
+func TestAnnotationOpenAPISchema(t *testing.T) {
+	schema := AnnotationStatusOpenAPISchema()
+	assert.Equal(t, "string", schema["type"])
+	assert.Equal(t, []interface{}{"pending", "running", "completed", "failed"}, schema["enum"])
+	assert.Equal(t, []interface{}{
+		"MyAnnotationStatusPending", "MyAnnotationStatusRunning", "MyAnnotationStatusCompleted", "MyAnnotationStatusFailed",
+	}, schema["x-enum-varnames"])
+
+	// Both lists describe the same values in the same order
+	values, names := AnnotationStatusValues(), schema["x-enum-varnames"].([]interface{})
+	require.Len(t, names, len(values))
+	for i, value := range values {
+		assert.Equal(t, value.String(), schema["enum"].([]interface{})[i])
+	}
+
+	b, err := json.Marshal(AnnotationCodeOpenAPISchema())
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"type":"integer","enum":[1,2,9],"x-enum-varnames":["AnnotationCodeOk","AnnotationCodeRetry","AnnotationCodeFatal"]}`, string(b))
+}
+
+func TestAnnotationNoCaseMapKeys(t *testing.T) {
+	// Map keys go through UnmarshalText, so @nocase keys may use any case
+	var counts map[AnnotationColor]int
+	require.NoError(t, json.Unmarshal([]byte(`{"ANNOTATION_RED":1,"Annotation_Blue":2,"annotation_green":3}`), &counts))
+	assert.Equal(t, map[AnnotationColor]int{AnnotationRed: 1, AnnotationBlue: 2, AnnotationGreen: 3}, counts)
+
+	b, err := json.Marshal(counts)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"annotation_red":1,"annotation_blue":2,"annotation_green":3}`, string(b))
+
+	assert.ErrorIs(t, json.Unmarshal([]byte(`{"purple":1}`), &counts), ErrInvalidAnnotationColor)
+}
+
+func TestAnnotationLogValue(t *testing.T) {
+	var _ slog.LogValuer = MyAnnotationStatusPending
+
+	v := slog.AnyValue(MyAnnotationStatusPending).Resolve()
+	assert.Equal(t, slog.KindString, v.Kind())
+	assert.Equal(t, "pending", v.String())
+
+	var buf bytes.Buffer
+	slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+		if len(groups) == 0 && (a.Key == slog.TimeKey || a.Key == slog.LevelKey) {
+			return slog.Attr{}
+		}
+		return a
+	}})).Info("job", "status", MyAnnotationStatusRunning)
+	assert.JSONEq(t, `{"msg":"job","status":"running"}`, buf.String())
+}
+
+// The names are package level constants, String and Parse hand them out without building new strings
+func TestAnnotationStringParseAllocs(t *testing.T) {
+	name := AnnotationStateRunning.String()
+	assert.Equal(t, "running", name)
+	assert.Zero(t, testing.AllocsPerRun(100, func() { _ = AnnotationStateRunning.String() }))
+	assert.Zero(t, testing.AllocsPerRun(100, func() { _, _ = ParseAnnotationState(name) }))
+	assert.Zero(t, testing.AllocsPerRun(100, func() { _, _ = ParseAnnotationColor("annotation_red") }))
+	assert.Zero(t, testing.AllocsPerRun(100, func() { _ = AnnotationCodeOk.String() }))
+	assert.Zero(t, testing.AllocsPerRun(100, func() { _, _ = ParseAnnotationCode(AnnotationCodeOk.String()) }))
+
+	state, err := ParseAnnotationState(name)
+	require.NoError(t, err)
+	assert.Equal(t, AnnotationStateRunning, state)
+}
+
+func BenchmarkAnnotationStringParse(b *testing.B) {
+	b.Run("String", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = AnnotationStateCompleted.String()
+		}
+	})
+	b.Run("Parse", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if _, err := ParseAnnotationState("completed"); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("IntString", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = AnnotationCodeOk.String()
+		}
+	})
+}
+
+func TestAnnotationTrimSpace(t *testing.T) {
+	state, err := ParseAnnotationState(" pending\n")
+	require.NoError(t, err)
+	assert.Equal(t, AnnotationStatePending, state)
+
+	require.NoError(t, state.UnmarshalText([]byte("\trunning ")))
+	assert.Equal(t, AnnotationStateRunning, state)
+
+	var job struct {
+		State AnnotationState `json:"state"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(`{"state":"completed\r\n"}`), &job))
+	assert.Equal(t, AnnotationStateCompleted, job.State)
+
+	// Whitespace inside the name is not trimmed
+	_, err = ParseAnnotationState("run ning")
+	assert.ErrorIs(t, err, ErrInvalidAnnotationState)
+	_, err = ParseAnnotationState(" \n")
+	assert.ErrorIs(t, err, ErrInvalidAnnotationState)
+
+	// Only the input of Parse is trimmed, a padded value is not valid
+	assert.False(t, AnnotationState(" pending").IsValid())
+	assert.True(t, AnnotationState("pending").IsValid())
+	priority, err := ParseAnnotationPriority(" high ")
+	require.NoError(t, err)
+	assert.Equal(t, AnnotationPriorityHigh, priority)
+	assert.False(t, AnnotationPriority(" high").IsValid())
+	assert.True(t, AnnotationPriority("high").IsValid())
+
+	// Without @trimspace the input is looked up as is
+	_, err = ParseAnnotationColor(" annotation_red")
+	assert.ErrorIs(t, err, ErrInvalidAnnotationColor)
+}
+
+// csvMarshaler and csvUnmarshaler mirror the TypeMarshaller and TypeUnmarshaller interfaces of gocarina/gocsv
+type (
+	csvMarshaler interface {
+		MarshalCSV() (string, error)
+	}
+	csvUnmarshaler interface {
+		UnmarshalCSV(string) error
+	}
+)
+
+func TestAnnotationCSV(t *testing.T) {
+	var (
+		_ csvMarshaler   = MyAnnotationStatusPending
+		_ csvUnmarshaler = new(AnnotationStatus)
+	)
+
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	for _, status := range []AnnotationStatus{MyAnnotationStatusPending, MyAnnotationStatusCompleted} {
+		field, err := status.MarshalCSV()
+		require.NoError(t, err)
+		require.NoError(t, w.Write([]string{field}))
+	}
+	w.Flush()
+	require.NoError(t, w.Error())
+	assert.Equal(t, "pending\ncompleted\n", buf.String())
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	require.NoError(t, err)
+	var statuses []AnnotationStatus
+	for _, record := range records {
+		var status AnnotationStatus
+		require.NoError(t, status.UnmarshalCSV(record[0]))
+		statuses = append(statuses, status)
+	}
+	assert.Equal(t, []AnnotationStatus{MyAnnotationStatusPending, MyAnnotationStatusCompleted}, statuses)
+
+	var status AnnotationStatus
+	assert.ErrorIs(t, status.UnmarshalCSV("unknown"), ErrInvalidAnnotationStatus)
+	// @strictmarshal refuses to write undeclared values
+	_, err = AnnotationStatus("unknown").MarshalCSV()
+	assert.ErrorIs(t, err, ErrInvalidAnnotationStatus)
+}
+
+func TestAnnotationScanTyped(t *testing.T) {
+	var number AnnotationNumber
+	require.NoError(t, number.Scan(AnnotationNumberThree))
+	assert.Equal(t, AnnotationNumberThree, number)
+	two := AnnotationNumberTwo
+	require.NoError(t, number.Scan(&two))
+	assert.Equal(t, AnnotationNumberTwo, number)
+
+	var color AnnotationColor
+	require.NoError(t, color.Scan(AnnotationBlue))
+	assert.Equal(t, AnnotationBlue, color)
+
+	// Typed values are still validated
+	unknown := AnnotationNumber(42)
+	assert.ErrorIs(t, number.Scan(unknown), ErrInvalidAnnotationNumber)
+	assert.ErrorIs(t, number.Scan(&unknown), ErrInvalidAnnotationNumber)
+	assert.ErrorIs(t, color.Scan(AnnotationColor("purple")), ErrInvalidAnnotationColor)
+	assert.Equal(t, AnnotationNumberTwo, number)
+	assert.Equal(t, AnnotationBlue, color)
+	assert.ErrorIs(t, number.Scan((*AnnotationNumber)(nil)), errAnnotationNumberNilPtr)
+}
+
+func TestAnnotationParseLongInput(t *testing.T) {
+	color, err := ParseAnnotationColor("ANNOTATION_RED")
+	require.NoError(t, err)
+	assert.Equal(t, AnnotationRed, color)
+
+	// Close to a megabyte of input, rejected without lowercasing it
+	long := strings.Repeat("ANNOTATION_RED", 1<<16)
+	_, err = ParseAnnotationColor(long)
+	assert.ErrorIs(t, err, ErrInvalidAnnotationColor)
+	_, err = ParseAnnotationColorBytes([]byte(long))
+	assert.ErrorIs(t, err, ErrInvalidAnnotationColor)
+}
+
+func FuzzParseAnnotationColor(f *testing.F) {
+	for _, color := range []AnnotationColor{AnnotationRed, AnnotationGreen, AnnotationBlue} {
+		f.Add(color.String())
+		f.Add(strings.ToUpper(color.String()))
+	}
+	f.Add(strings.Repeat("A", 1<<10))
+	f.Fuzz(func(t *testing.T, name string) {
+		color, err := ParseAnnotationColor(name)
+		if err != nil {
+			require.ErrorIs(t, err, ErrInvalidAnnotationColor)
+			return
+		}
+		require.True(t, color.IsValid())
+		require.Equal(t, color.String(), strings.ToLower(name))
+	})
+}
+
+func TestAnnotationOneOf(t *testing.T) {
+	assert.Equal(t, "pending running completed failed", AnnotationStatusOneOf())
+	// Integer fields are checked against the numbers
+	assert.Equal(t, "1 2 9", AnnotationCodeOneOf())
+
+	for _, status := range strings.Fields(AnnotationStatusOneOf()) {
+		assert.True(t, AnnotationStatus(status).IsValid(), status)
+	}
+	assert.NoError(t, AnnotationCodeFatal.Validate())
+
+	// A test like this one fails when a value is added without updating the tag
+	type job struct {
+		Status AnnotationStatus `validate:"oneof=pending running completed failed"`
+	}
+	field, _ := reflect.TypeFor[job]().FieldByName("Status")
+	assert.Equal(t, "oneof="+AnnotationStatusOneOf(), field.Tag.Get("validate"))
+}
+
+func TestAnnotationAppendJSON(t *testing.T) {
+	appendJSON := func(v interface{ AppendJSON([]byte) ([]byte, error) }) {
+		t.Helper()
+		expected, err := json.Marshal(v)
+		require.NoError(t, err)
+		b, err := v.AppendJSON([]byte(`{"v":`))
+		require.NoError(t, err)
+		assert.Equal(t, `{"v":`+string(expected), string(b))
+	}
+	appendJSON(MyAnnotationStatusRunning)
+	appendJSON(AnnotationNumberTwo)
+	appendJSON(AnnotationCodeFatal)
+	appendJSON(AnnotationGreen)
+	// Undeclared string values are escaped like encoding/json does
+	appendJSON(AnnotationColor(`<"purple">`))
+
+	b, err := AnnotationCodeRetry.AppendJSON(nil)
+	require.NoError(t, err)
+	assert.Equal(t, "2", string(b))
+
+	// @strictmarshal refuses undeclared values
+	_, err = AnnotationStatus("unknown").AppendJSON(nil)
+	assert.ErrorIs(t, err, ErrInvalidAnnotationStatus)
+	_, err = AnnotationNumber(42).AppendJSON(nil)
+	assert.ErrorIs(t, err, ErrInvalidAnnotationNumber)
+
+	buf := make([]byte, 0, 64)
+	assert.Zero(t, testing.AllocsPerRun(100, func() { _, _ = MyAnnotationStatusCompleted.AppendJSON(buf[:0]) }))
+	assert.Zero(t, testing.AllocsPerRun(100, func() { _, _ = AnnotationNumberThree.AppendJSON(buf[:0]) }))
+	assert.Zero(t, testing.AllocsPerRun(100, func() { _, _ = AnnotationCodeFatal.AppendJSON(buf[:0]) }))
+}
+
+func BenchmarkAnnotationAppendJSON(b *testing.B) {
+	b.Run("MarshalJSON", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if _, err := AnnotationNumberThree.MarshalJSON(); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("AppendJSON", func(b *testing.B) {
+		b.ReportAllocs()
+		buf := make([]byte, 0, 64)
+		for i := 0; i < b.N; i++ {
+			var err error
+			if buf, err = AnnotationNumberThree.AppendJSON(buf[:0]); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
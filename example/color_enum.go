@@ -116,13 +116,22 @@ func MustParseColor(name string) Color {
 	return val
 }
 
+// Ptr returns a pointer to a copy of x, for use in optional fields.
 func (x Color) Ptr() *Color {
 	return &x
 }
 
+// PtrString returns the name of the value x points to, or "" when x is nil, so optional fields print without a nil check.
+func (x *Color) PtrString() string {
+	if x == nil {
+		return ""
+	}
+	return x.String()
+}
+
 // MarshalText implements the text marshaller method.
 func (x Color) MarshalText() ([]byte, error) {
-	return []byte(x.String()), nil
+	return x.AppendText(nil)
 }
 
 // UnmarshalText implements the text unmarshaller method.
@@ -140,6 +149,6 @@ func (x *Color) UnmarshalText(text []byte) error {
 // (allocating a larger slice if necessary) and returns the updated slice.
 //
 // Implementations must not retain b, nor mutate any bytes within b[:len(b)].
-func (x *Color) AppendText(b []byte) ([]byte, error) {
+func (x Color) AppendText(b []byte) ([]byte, error) {
 	return append(b, x.String()...), nil
 }
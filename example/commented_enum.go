@@ -70,7 +70,7 @@ func ParseCommented(name string) (Commented, error) {
 
 // MarshalText implements the text marshaller method.
 func (x Commented) MarshalText() ([]byte, error) {
-	return []byte(x.String()), nil
+	return x.AppendText(nil)
 }
 
 // UnmarshalText implements the text unmarshaller method.
@@ -88,7 +88,7 @@ func (x *Commented) UnmarshalText(text []byte) error {
 // (allocating a larger slice if necessary) and returns the updated slice.
 //
 // Implementations must not retain b, nor mutate any bytes within b[:len(b)].
-func (x *Commented) AppendText(b []byte) ([]byte, error) {
+func (x Commented) AppendText(b []byte) ([]byte, error) {
 	return append(b, x.String()...), nil
 }
 
@@ -150,7 +150,7 @@ func ParseComplexCommented(name string) (ComplexCommented, error) {
 
 // MarshalText implements the text marshaller method.
 func (x ComplexCommented) MarshalText() ([]byte, error) {
-	return []byte(x.String()), nil
+	return x.AppendText(nil)
 }
 
 // UnmarshalText implements the text unmarshaller method.
@@ -168,6 +168,6 @@ func (x *ComplexCommented) UnmarshalText(text []byte) error {
 // (allocating a larger slice if necessary) and returns the updated slice.
 //
 // Implementations must not retain b, nor mutate any bytes within b[:len(b)].
-func (x *ComplexCommented) AppendText(b []byte) ([]byte, error) {
+func (x ComplexCommented) AppendText(b []byte) ([]byte, error) {
 	return append(b, x.String()...), nil
 }
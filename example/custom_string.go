@@ -0,0 +1,11 @@
+//go:generate ../bin/go-enum --marshal -b example
+
+package example
+
+// TaskState is a string enumeration whose text differs from the constant names.
+// ENUM(InProgress="in-progress", Done="done", on_hold="on-hold")
+type TaskState string
+
+// TaskPhase is an int enumeration whose text differs from the constant names.
+// ENUM(NotStarted="not-started", InProgress="in-progress", Done="done")
+type TaskPhase int
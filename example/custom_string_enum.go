@@ -0,0 +1,147 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: example
+// Revision: example
+// Build Date: example
+// Built By: example
+
+//go:build example
+// +build example
+
+package example
+
+import (
+	"errors"
+	"fmt"
+)
+
+const (
+	// TaskPhaseNotStarted is a TaskPhase of type NotStarted.
+	TaskPhaseNotStarted TaskPhase = iota
+	// TaskPhaseInProgress is a TaskPhase of type InProgress.
+	TaskPhaseInProgress
+	// TaskPhaseDone is a TaskPhase of type Done.
+	TaskPhaseDone
+)
+
+var ErrInvalidTaskPhase = errors.New("not a valid TaskPhase")
+
+const _TaskPhaseName = "not-startedin-progressdone"
+
+var _TaskPhaseMap = map[TaskPhase]string{
+	TaskPhaseNotStarted: _TaskPhaseName[0:11],
+	TaskPhaseInProgress: _TaskPhaseName[11:22],
+	TaskPhaseDone:       _TaskPhaseName[22:26],
+}
+
+// String implements the Stringer interface.
+func (x TaskPhase) String() string {
+	if str, ok := _TaskPhaseMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("TaskPhase(%d)", x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x TaskPhase) IsValid() bool {
+	_, ok := _TaskPhaseMap[x]
+	return ok
+}
+
+var _TaskPhaseValue = map[string]TaskPhase{
+	_TaskPhaseName[0:11]:  TaskPhaseNotStarted,
+	_TaskPhaseName[11:22]: TaskPhaseInProgress,
+	_TaskPhaseName[22:26]: TaskPhaseDone,
+}
+
+// ParseTaskPhase attempts to convert a string to a TaskPhase.
+func ParseTaskPhase(name string) (TaskPhase, error) {
+	if x, ok := _TaskPhaseValue[name]; ok {
+		return x, nil
+	}
+	return TaskPhase(0), fmt.Errorf("%s is %w", name, ErrInvalidTaskPhase)
+}
+
+// MarshalText implements the text marshaller method.
+func (x TaskPhase) MarshalText() ([]byte, error) {
+	return x.AppendText(nil)
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *TaskPhase) UnmarshalText(text []byte) error {
+	name := string(text)
+	tmp, err := ParseTaskPhase(name)
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// AppendText appends the textual representation of itself to the end of b
+// (allocating a larger slice if necessary) and returns the updated slice.
+//
+// Implementations must not retain b, nor mutate any bytes within b[:len(b)].
+func (x TaskPhase) AppendText(b []byte) ([]byte, error) {
+	return append(b, x.String()...), nil
+}
+
+const (
+	// TaskStateInProgress is a TaskState of type InProgress.
+	TaskStateInProgress TaskState = "in-progress"
+	// TaskStateDone is a TaskState of type Done.
+	TaskStateDone TaskState = "done"
+	// TaskStateOnHold is a TaskState of type on_hold.
+	TaskStateOnHold TaskState = "on-hold"
+)
+
+var ErrInvalidTaskState = errors.New("not a valid TaskState")
+
+// String implements the Stringer interface.
+func (x TaskState) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x TaskState) IsValid() bool {
+	_, err := ParseTaskState(string(x))
+	return err == nil
+}
+
+var _TaskStateValue = map[string]TaskState{
+	"in-progress": TaskStateInProgress,
+	"done":        TaskStateDone,
+	"on-hold":     TaskStateOnHold,
+}
+
+// ParseTaskState attempts to convert a string to a TaskState.
+func ParseTaskState(name string) (TaskState, error) {
+	if x, ok := _TaskStateValue[name]; ok {
+		return x, nil
+	}
+	return TaskState(""), fmt.Errorf("%s is %w", name, ErrInvalidTaskState)
+}
+
+// MarshalText implements the text marshaller method.
+func (x TaskState) MarshalText() ([]byte, error) {
+	return x.AppendText(nil)
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *TaskState) UnmarshalText(text []byte) error {
+	tmp, err := ParseTaskState(string(text))
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// AppendText appends the textual representation of itself to the end of b
+// (allocating a larger slice if necessary) and returns the updated slice.
+//
+// Implementations must not retain b, nor mutate any bytes within b[:len(b)].
+func (x TaskState) AppendText(b []byte) ([]byte, error) {
+	return append(b, x.String()...), nil
+}
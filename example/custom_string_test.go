@@ -0,0 +1,41 @@
+//go:build example
+// +build example
+
+package example
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaskStateCustomString(t *testing.T) {
+	assert.Equal(t, "in-progress", TaskStateInProgress.String())
+	assert.Equal(t, "on-hold", TaskStateOnHold.String())
+
+	parsed, err := ParseTaskState("in-progress")
+	require.NoError(t, err)
+	assert.Equal(t, TaskStateInProgress, parsed)
+
+	_, err = ParseTaskState("InProgress")
+	assert.ErrorIs(t, err, ErrInvalidTaskState)
+}
+
+func TestTaskPhaseCustomString(t *testing.T) {
+	assert.Equal(t, TaskPhase(1), TaskPhaseInProgress)
+	assert.Equal(t, "not-started", TaskPhaseNotStarted.String())
+	assert.Equal(t, "in-progress", TaskPhaseInProgress.String())
+
+	parsed, err := ParseTaskPhase("in-progress")
+	require.NoError(t, err)
+	assert.Equal(t, TaskPhaseInProgress, parsed)
+
+	_, err = ParseTaskPhase("InProgress")
+	assert.ErrorIs(t, err, ErrInvalidTaskPhase)
+
+	b, err := json.Marshal(TaskPhaseDone)
+	require.NoError(t, err)
+	assert.Equal(t, `"done"`, string(b))
+}
@@ -0,0 +1,34 @@
+//go:generate ../bin/go-enum --marshal -b example
+
+package example
+
+import "strings"
+
+// Weekday keeps a hand written String method, the generated one is left out.
+// @nostring
+// ENUM(monday, tuesday, wednesday)
+type Weekday int
+
+// String implements the Stringer interface with a capitalized name.
+// It can't use the generated constants as they are behind the example build tag.
+func (x Weekday) String() string {
+	switch x {
+	case 0:
+		return "Monday"
+	case 1:
+		return "Tuesday"
+	case 2:
+		return "Wednesday"
+	}
+	return "Weekday(?)"
+}
+
+// Currency keeps a hand written String method, the generated one is left out.
+// @nostring
+// ENUM(usd, eur)
+type Currency string
+
+// String implements the Stringer interface with an upper case code.
+func (x Currency) String() string {
+	return strings.ToUpper(string(x))
+}
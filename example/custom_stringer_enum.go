@@ -0,0 +1,131 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: example
+// Revision: example
+// Build Date: example
+// Built By: example
+
+//go:build example
+// +build example
+
+package example
+
+import (
+	"errors"
+	"fmt"
+)
+
+const (
+	// CurrencyUsd is a Currency of type usd.
+	CurrencyUsd Currency = "usd"
+	// CurrencyEur is a Currency of type eur.
+	CurrencyEur Currency = "eur"
+)
+
+var ErrInvalidCurrency = errors.New("not a valid Currency")
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x Currency) IsValid() bool {
+	_, err := ParseCurrency(string(x))
+	return err == nil
+}
+
+var _CurrencyValue = map[string]Currency{
+	"usd": CurrencyUsd,
+	"eur": CurrencyEur,
+}
+
+// ParseCurrency attempts to convert a string to a Currency.
+func ParseCurrency(name string) (Currency, error) {
+	if x, ok := _CurrencyValue[name]; ok {
+		return x, nil
+	}
+	return Currency(""), fmt.Errorf("%s is %w", name, ErrInvalidCurrency)
+}
+
+// MarshalText implements the text marshaller method.
+func (x Currency) MarshalText() ([]byte, error) {
+	return x.AppendText(nil)
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *Currency) UnmarshalText(text []byte) error {
+	tmp, err := ParseCurrency(string(text))
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// AppendText appends the textual representation of itself to the end of b
+// (allocating a larger slice if necessary) and returns the updated slice.
+//
+// Implementations must not retain b, nor mutate any bytes within b[:len(b)].
+func (x Currency) AppendText(b []byte) ([]byte, error) {
+	return append(b, x.String()...), nil
+}
+
+const (
+	// WeekdayMonday is a Weekday of type Monday.
+	WeekdayMonday Weekday = iota
+	// WeekdayTuesday is a Weekday of type Tuesday.
+	WeekdayTuesday
+	// WeekdayWednesday is a Weekday of type Wednesday.
+	WeekdayWednesday
+)
+
+var ErrInvalidWeekday = errors.New("not a valid Weekday")
+
+const _WeekdayName = "mondaytuesdaywednesday"
+
+var _WeekdayMap = map[Weekday]string{
+	WeekdayMonday:    _WeekdayName[0:6],
+	WeekdayTuesday:   _WeekdayName[6:13],
+	WeekdayWednesday: _WeekdayName[13:22],
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x Weekday) IsValid() bool {
+	_, ok := _WeekdayMap[x]
+	return ok
+}
+
+var _WeekdayValue = map[string]Weekday{
+	_WeekdayName[0:6]:   WeekdayMonday,
+	_WeekdayName[6:13]:  WeekdayTuesday,
+	_WeekdayName[13:22]: WeekdayWednesday,
+}
+
+// ParseWeekday attempts to convert a string to a Weekday.
+func ParseWeekday(name string) (Weekday, error) {
+	if x, ok := _WeekdayValue[name]; ok {
+		return x, nil
+	}
+	return Weekday(0), fmt.Errorf("%s is %w", name, ErrInvalidWeekday)
+}
+
+// MarshalText implements the text marshaller method.
+func (x Weekday) MarshalText() ([]byte, error) {
+	return x.AppendText(nil)
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *Weekday) UnmarshalText(text []byte) error {
+	name := string(text)
+	tmp, err := ParseWeekday(name)
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// AppendText appends the textual representation of itself to the end of b
+// (allocating a larger slice if necessary) and returns the updated slice.
+//
+// Implementations must not retain b, nor mutate any bytes within b[:len(b)].
+func (x Weekday) AppendText(b []byte) ([]byte, error) {
+	return append(b, x.String()...), nil
+}
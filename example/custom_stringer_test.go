@@ -0,0 +1,37 @@
+//go:build example
+// +build example
+
+package example
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNoStringUsesHandWrittenString(t *testing.T) {
+	assert.Equal(t, "Tuesday", WeekdayTuesday.String())
+	assert.Equal(t, "Weekday(?)", Weekday(42).String())
+	assert.True(t, WeekdayWednesday.IsValid())
+
+	x, err := ParseWeekday("monday")
+	require.NoError(t, err)
+	assert.Equal(t, WeekdayMonday, x)
+
+	// Marshaling goes through the hand written String
+	b, err := json.Marshal(WeekdayMonday)
+	require.NoError(t, err)
+	assert.Equal(t, `"Monday"`, string(b))
+
+	assert.Equal(t, "EUR", CurrencyEur.String())
+	assert.True(t, CurrencyUsd.IsValid())
+	b, err = json.Marshal(CurrencyUsd)
+	require.NoError(t, err)
+	assert.Equal(t, `"USD"`, string(b))
+
+	var c Currency
+	require.NoError(t, json.Unmarshal([]byte(`"eur"`), &c))
+	assert.Equal(t, CurrencyEur, c)
+}
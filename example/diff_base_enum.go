@@ -12,6 +12,7 @@ package example
 import (
 	"errors"
 	"fmt"
+	"strings"
 )
 
 const (
@@ -83,5 +84,11 @@ func ParseDiffBase(name string) (DiffBase, error) {
 	if x, ok := _DiffBaseValue[name]; ok {
 		return x, nil
 	}
+	// Names are forced to lower case, so normalize the input the same way, unless it is too long to be a name.
+	if len(name) <= 12 {
+		if x, ok := _DiffBaseValue[strings.ToLower(name)]; ok {
+			return x, nil
+		}
+	}
 	return DiffBase(0), fmt.Errorf("%s is %w", name, ErrInvalidDiffBase)
 }
@@ -0,0 +1,12 @@
+//go:generate ../bin/go-enum -b example
+
+package example
+
+// Volume uses its own template, which shouts the names back from String().
+// @template:"enum_template.tmpl"
+// ENUM(quiet, loud)
+type Volume int
+
+// Tone keeps the built-in templates.
+// ENUM(quiet, loud)
+type Tone int
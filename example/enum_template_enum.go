@@ -0,0 +1,109 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: example
+// Revision: example
+// Build Date: example
+// Built By: example
+
+//go:build example
+// +build example
+
+package example
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const (
+	// ToneQuiet is a Tone of type Quiet.
+	ToneQuiet Tone = iota
+	// ToneLoud is a Tone of type Loud.
+	ToneLoud
+)
+
+var ErrInvalidTone = errors.New("not a valid Tone")
+
+const _ToneName = "quietloud"
+
+var _ToneMap = map[Tone]string{
+	ToneQuiet: _ToneName[0:5],
+	ToneLoud:  _ToneName[5:9],
+}
+
+// String implements the Stringer interface.
+func (x Tone) String() string {
+	if str, ok := _ToneMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("Tone(%d)", x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x Tone) IsValid() bool {
+	_, ok := _ToneMap[x]
+	return ok
+}
+
+var _ToneValue = map[string]Tone{
+	_ToneName[0:5]: ToneQuiet,
+	_ToneName[5:9]: ToneLoud,
+}
+
+// ParseTone attempts to convert a string to a Tone.
+func ParseTone(name string) (Tone, error) {
+	if x, ok := _ToneValue[name]; ok {
+		return x, nil
+	}
+	return Tone(0), fmt.Errorf("%s is %w", name, ErrInvalidTone)
+}
+
+const (
+	// VolumeQuiet is a Volume of type Quiet.
+	VolumeQuiet Volume = iota
+	// VolumeLoud is a Volume of type Loud.
+	VolumeLoud
+)
+
+var ErrInvalidVolume = errors.New("not a valid Volume")
+
+const _VolumeName = "quietloud"
+
+var _VolumeMap = map[Volume]string{
+	VolumeQuiet: _VolumeName[0:5],
+	VolumeLoud:  _VolumeName[5:9],
+}
+
+// String implements the Stringer interface, shouting the name of the value.
+func (x Volume) String() string {
+	if str, ok := _VolumeMap[x]; ok {
+		return strings.ToUpper(str)
+	}
+	return fmt.Sprintf("Volume(%d)", x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x Volume) IsValid() bool {
+	_, ok := _VolumeMap[x]
+	return ok
+}
+
+var _VolumeValue = map[string]Volume{
+	_VolumeName[0:5]: VolumeQuiet,
+	_VolumeName[5:9]: VolumeLoud,
+}
+
+// ParseVolume attempts to convert a string to a Volume.
+func ParseVolume(name string) (Volume, error) {
+	if x, ok := _VolumeValue[name]; ok {
+		return x, nil
+	}
+	return Volume(0), fmt.Errorf("%s is %w", name, ErrInvalidVolume)
+}
+
+// VolumeLoudest returns the last declared value of Volume.
+func VolumeLoudest() Volume {
+	return VolumeLoud
+}
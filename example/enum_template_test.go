@@ -0,0 +1,22 @@
+//go:build example
+// +build example
+
+package example
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnumTemplateAnnotation(t *testing.T) {
+	// The @template file replaces String() for Volume only
+	assert.Equal(t, "LOUD", VolumeLoud.String())
+	assert.Equal(t, "loud", ToneLoud.String())
+	assert.Equal(t, VolumeLoud, VolumeLoudest())
+
+	// Parsing still goes through the built-in lookups
+	x, err := ParseVolume("quiet")
+	assert.NoError(t, err)
+	assert.Equal(t, VolumeQuiet, x)
+}
@@ -131,27 +131,30 @@ func (x Make) IsValid() bool {
 }
 
 var _MakeValue = map[string]Make{
-	_MakeName[0:6]:                    MakeToyota,
+	_MakeName[0:6]:   MakeToyota,
+	_MakeName[6:11]:  MakeChevy,
+	_MakeName[11:15]: MakeFord,
+	_MakeName[15:20]: MakeTesla,
+	_MakeName[20:27]: MakeHyundai,
+	_MakeName[27:33]: MakeNissan,
+	_MakeName[33:39]: MakeJaguar,
+	_MakeName[39:43]: MakeAudi,
+	_MakeName[43:46]: MakeBMW,
+	_MakeName[46:59]: MakeMercedesBenz,
+	_MakeName[59:69]: MakeVolkswagon,
+}
+
+var _MakeLowerValue = map[string]Make{
 	strings.ToLower(_MakeName[0:6]):   MakeToyota,
-	_MakeName[6:11]:                   MakeChevy,
 	strings.ToLower(_MakeName[6:11]):  MakeChevy,
-	_MakeName[11:15]:                  MakeFord,
 	strings.ToLower(_MakeName[11:15]): MakeFord,
-	_MakeName[15:20]:                  MakeTesla,
 	strings.ToLower(_MakeName[15:20]): MakeTesla,
-	_MakeName[20:27]:                  MakeHyundai,
 	strings.ToLower(_MakeName[20:27]): MakeHyundai,
-	_MakeName[27:33]:                  MakeNissan,
 	strings.ToLower(_MakeName[27:33]): MakeNissan,
-	_MakeName[33:39]:                  MakeJaguar,
 	strings.ToLower(_MakeName[33:39]): MakeJaguar,
-	_MakeName[39:43]:                  MakeAudi,
 	strings.ToLower(_MakeName[39:43]): MakeAudi,
-	_MakeName[43:46]:                  MakeBMW,
 	strings.ToLower(_MakeName[43:46]): MakeBMW,
-	_MakeName[46:59]:                  MakeMercedesBenz,
 	strings.ToLower(_MakeName[46:59]): MakeMercedesBenz,
-	_MakeName[59:69]:                  MakeVolkswagon,
 	strings.ToLower(_MakeName[59:69]): MakeVolkswagon,
 }
 
@@ -161,15 +164,18 @@ func ParseMake(name string) (Make, error) {
 		return x, nil
 	}
 	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of lowercasing a string if we don't need to.
-	if x, ok := _MakeValue[strings.ToLower(name)]; ok {
-		return x, nil
+	// Inputs too long to lowercase into a name are not normalized at all.
+	if len(name) <= 52 {
+		if x, ok := _MakeLowerValue[strings.ToLower(name)]; ok {
+			return x, nil
+		}
 	}
 	return Make(0), fmt.Errorf("%s is %w", name, ErrInvalidMake)
 }
 
 // MarshalText implements the text marshaller method.
 func (x Make) MarshalText() ([]byte, error) {
-	return []byte(x.String()), nil
+	return x.AppendText(nil)
 }
 
 // UnmarshalText implements the text unmarshaller method.
@@ -187,7 +193,7 @@ func (x *Make) UnmarshalText(text []byte) error {
 // (allocating a larger slice if necessary) and returns the updated slice.
 //
 // Implementations must not retain b, nor mutate any bytes within b[:len(b)].
-func (x *Make) AppendText(b []byte) ([]byte, error) {
+func (x Make) AppendText(b []byte) ([]byte, error) {
 	return append(b, x.String()...), nil
 }
 
@@ -280,17 +286,20 @@ func (x NoZeros) IsValid() bool {
 }
 
 var _NoZerosValue = map[string]NoZeros{
-	_NoZerosName[0:5]:                    NoZerosStart,
+	_NoZerosName[0:5]:   NoZerosStart,
+	_NoZerosName[5:11]:  NoZerosMiddle,
+	_NoZerosName[11:14]: NoZerosEnd,
+	_NoZerosName[14:16]: NoZerosPs,
+	_NoZerosName[16:19]: NoZerosPps,
+	_NoZerosName[19:23]: NoZerosPpps,
+}
+
+var _NoZerosLowerValue = map[string]NoZeros{
 	strings.ToLower(_NoZerosName[0:5]):   NoZerosStart,
-	_NoZerosName[5:11]:                   NoZerosMiddle,
 	strings.ToLower(_NoZerosName[5:11]):  NoZerosMiddle,
-	_NoZerosName[11:14]:                  NoZerosEnd,
 	strings.ToLower(_NoZerosName[11:14]): NoZerosEnd,
-	_NoZerosName[14:16]:                  NoZerosPs,
 	strings.ToLower(_NoZerosName[14:16]): NoZerosPs,
-	_NoZerosName[16:19]:                  NoZerosPps,
 	strings.ToLower(_NoZerosName[16:19]): NoZerosPps,
-	_NoZerosName[19:23]:                  NoZerosPpps,
 	strings.ToLower(_NoZerosName[19:23]): NoZerosPpps,
 }
 
@@ -300,15 +309,18 @@ func ParseNoZeros(name string) (NoZeros, error) {
 		return x, nil
 	}
 	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of lowercasing a string if we don't need to.
-	if x, ok := _NoZerosValue[strings.ToLower(name)]; ok {
-		return x, nil
+	// Inputs too long to lowercase into a name are not normalized at all.
+	if len(name) <= 24 {
+		if x, ok := _NoZerosLowerValue[strings.ToLower(name)]; ok {
+			return x, nil
+		}
 	}
 	return NoZeros(0), fmt.Errorf("%s is %w", name, ErrInvalidNoZeros)
 }
 
 // MarshalText implements the text marshaller method.
 func (x NoZeros) MarshalText() ([]byte, error) {
-	return []byte(x.String()), nil
+	return x.AppendText(nil)
 }
 
 // UnmarshalText implements the text unmarshaller method.
@@ -326,7 +338,7 @@ func (x *NoZeros) UnmarshalText(text []byte) error {
 // (allocating a larger slice if necessary) and returns the updated slice.
 //
 // Implementations must not retain b, nor mutate any bytes within b[:len(b)].
-func (x *NoZeros) AppendText(b []byte) ([]byte, error) {
+func (x NoZeros) AppendText(b []byte) ([]byte, error) {
 	return append(b, x.String()...), nil
 }
 
@@ -12,6 +12,7 @@ package example
 import (
 	"errors"
 	"fmt"
+	"strings"
 )
 
 const (
@@ -55,5 +56,11 @@ func ParseForceLowerType(name string) (ForceLowerType, error) {
 	if x, ok := _ForceLowerTypeValue[name]; ok {
 		return x, nil
 	}
+	// Names are forced to lower case, so normalize the input the same way, unless it is too long to be a name.
+	if len(name) <= 32 {
+		if x, ok := _ForceLowerTypeValue[strings.ToLower(name)]; ok {
+			return x, nil
+		}
+	}
 	return ForceLowerType(0), fmt.Errorf("%s is %w", name, ErrInvalidForceLowerType)
 }
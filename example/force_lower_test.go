@@ -40,3 +40,22 @@ func TestForceLowerString(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func TestForceLowerParseNormalizesInput(t *testing.T) {
+	tests := map[string]ForceLowerType{
+		"DATASWAP": ForceLowerTypeDataSwap,
+		"DataSwap": ForceLowerTypeDataSwap,
+		"bootNode": ForceLowerTypeBootNode,
+	}
+
+	for input, expected := range tests {
+		t.Run(input, func(t *testing.T) {
+			output, err := ParseForceLowerType(input)
+			assert.NoError(t, err)
+			assert.Equal(t, expected, output)
+		})
+	}
+
+	_, err := ParseForceLowerType("data_swap")
+	assert.ErrorIs(t, err, ErrInvalidForceLowerType)
+}
@@ -12,6 +12,7 @@ package example
 import (
 	"errors"
 	"fmt"
+	"strings"
 )
 
 const (
@@ -55,5 +56,11 @@ func ParseForceUpperType(name string) (ForceUpperType, error) {
 	if x, ok := _ForceUpperTypeValue[name]; ok {
 		return x, nil
 	}
+	// Names are forced to upper case, so normalize the input the same way, unless it is too long to be a name.
+	if len(name) <= 32 {
+		if x, ok := _ForceUpperTypeValue[strings.ToUpper(name)]; ok {
+			return x, nil
+		}
+	}
 	return ForceUpperType(0), fmt.Errorf("%s is %w", name, ErrInvalidForceUpperType)
 }
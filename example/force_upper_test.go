@@ -40,3 +40,22 @@ func TestForceUpperString(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func TestForceUpperParseNormalizesInput(t *testing.T) {
+	tests := map[string]ForceUpperType{
+		"dataswap": ForceUpperTypeDataSwap,
+		"DataSwap": ForceUpperTypeDataSwap,
+		"bootNode": ForceUpperTypeBootNode,
+	}
+
+	for input, expected := range tests {
+		t.Run(input, func(t *testing.T) {
+			output, err := ParseForceUpperType(input)
+			assert.NoError(t, err)
+			assert.Equal(t, expected, output)
+		})
+	}
+
+	_, err := ParseForceUpperType("data_swap")
+	assert.ErrorIs(t, err, ErrInvalidForceUpperType)
+}
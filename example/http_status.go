@@ -0,0 +1,7 @@
+//go:generate ../bin/go-enum --ordinal -b example
+
+package example
+
+// HTTPStatus is an enumeration of wire protocol status codes with explicit values.
+// ENUM(OK=200, Created, NotFound=404, Error=500)
+type HTTPStatus int
@@ -0,0 +1,134 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: example
+// Revision: example
+// Build Date: example
+// Built By: example
+
+//go:build example
+// +build example
+
+package example
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+)
+
+const (
+	// HTTPStatusOK is a HTTPStatus of type OK.
+	HTTPStatusOK HTTPStatus = iota + 200
+	// HTTPStatusCreated is a HTTPStatus of type Created.
+	HTTPStatusCreated
+	// HTTPStatusNotFound is a HTTPStatus of type NotFound.
+	HTTPStatusNotFound HTTPStatus = iota + 402
+	// HTTPStatusError is a HTTPStatus of type Error.
+	HTTPStatusError HTTPStatus = iota + 497
+)
+
+var ErrInvalidHTTPStatus = errors.New("not a valid HTTPStatus")
+
+const _HTTPStatusName = "OKCreatedNotFoundError"
+
+var _HTTPStatusMap = map[HTTPStatus]string{
+	HTTPStatusOK:       _HTTPStatusName[0:2],
+	HTTPStatusCreated:  _HTTPStatusName[2:9],
+	HTTPStatusNotFound: _HTTPStatusName[9:17],
+	HTTPStatusError:    _HTTPStatusName[17:22],
+}
+
+// String implements the Stringer interface.
+func (x HTTPStatus) String() string {
+	if str, ok := _HTTPStatusMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("HTTPStatus(%d)", x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x HTTPStatus) IsValid() bool {
+	_, ok := _HTTPStatusMap[x]
+	return ok
+}
+
+var _HTTPStatusValue = map[string]HTTPStatus{
+	_HTTPStatusName[0:2]:   HTTPStatusOK,
+	_HTTPStatusName[2:9]:   HTTPStatusCreated,
+	_HTTPStatusName[9:17]:  HTTPStatusNotFound,
+	_HTTPStatusName[17:22]: HTTPStatusError,
+}
+
+// ParseHTTPStatus attempts to convert a string to a HTTPStatus.
+func ParseHTTPStatus(name string) (HTTPStatus, error) {
+	if x, ok := _HTTPStatusValue[name]; ok {
+		return x, nil
+	}
+	return HTTPStatus(0), fmt.Errorf("%s is %w", name, ErrInvalidHTTPStatus)
+}
+
+// Next returns the value declared after x, wrapping around to the first one.
+func (x HTTPStatus) Next() HTTPStatus {
+	switch x {
+	case HTTPStatusOK:
+		return HTTPStatusCreated
+	case HTTPStatusCreated:
+		return HTTPStatusNotFound
+	case HTTPStatusNotFound:
+		return HTTPStatusError
+	case HTTPStatusError:
+		return HTTPStatusOK
+	}
+	return x
+}
+
+// Prev returns the value declared before x, wrapping around to the last one.
+func (x HTTPStatus) Prev() HTTPStatus {
+	switch x {
+	case HTTPStatusOK:
+		return HTTPStatusError
+	case HTTPStatusCreated:
+		return HTTPStatusOK
+	case HTTPStatusNotFound:
+		return HTTPStatusCreated
+	case HTTPStatusError:
+		return HTTPStatusNotFound
+	}
+	return x
+}
+
+// Index returns the 0-based declaration position of x, or -1 if x is not a declared value.
+func (x HTTPStatus) Index() int {
+	switch x {
+	case HTTPStatusOK:
+		return 0
+	case HTTPStatusCreated:
+		return 1
+	case HTTPStatusNotFound:
+		return 2
+	case HTTPStatusError:
+		return 3
+	}
+	return -1
+}
+
+// Compare returns -1, 0 or +1 depending on whether x is declared before, at or after other.
+// Values that are not declared sort before all the declared ones.
+func (x HTTPStatus) Compare(other HTTPStatus) int {
+	return cmp.Compare(x.Index(), other.Index())
+}
+
+// HTTPStatusFromIndex returns the HTTPStatus declared at the 0-based position i.
+func HTTPStatusFromIndex(i int) (HTTPStatus, error) {
+	switch i {
+	case 0:
+		return HTTPStatusOK, nil
+	case 1:
+		return HTTPStatusCreated, nil
+	case 2:
+		return HTTPStatusNotFound, nil
+	case 3:
+		return HTTPStatusError, nil
+	}
+	return HTTPStatus(0), fmt.Errorf("index %d is %w", i, ErrInvalidHTTPStatus)
+}
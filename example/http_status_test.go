@@ -0,0 +1,75 @@
+//go:build example
+// +build example
+
+package example
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHTTPStatusValues(t *testing.T) {
+	assert.Equal(t, HTTPStatus(200), HTTPStatusOK)
+	assert.Equal(t, HTTPStatus(201), HTTPStatusCreated)
+	assert.Equal(t, HTTPStatus(404), HTTPStatusNotFound)
+	assert.Equal(t, HTTPStatus(500), HTTPStatusError)
+
+	assert.True(t, HTTPStatusNotFound.IsValid())
+	assert.False(t, HTTPStatus(999).IsValid())
+	assert.False(t, HTTPStatus(202).IsValid())
+}
+
+func TestHTTPStatusParse(t *testing.T) {
+	tests := map[string]HTTPStatus{
+		"OK":       HTTPStatusOK,
+		"Created":  HTTPStatusCreated,
+		"NotFound": HTTPStatusNotFound,
+		"Error":    HTTPStatusError,
+	}
+	for name, expected := range tests {
+		t.Run(name, func(t *testing.T) {
+			parsed, err := ParseHTTPStatus(name)
+			require.NoError(t, err)
+			assert.Equal(t, expected, parsed)
+			assert.Equal(t, name, parsed.String())
+		})
+	}
+
+	_, err := ParseHTTPStatus("Teapot")
+	assert.EqualError(t, err, "Teapot is not a valid HTTPStatus")
+	assert.Equal(t, "HTTPStatus(999)", HTTPStatus(999).String())
+}
+
+func TestHTTPStatusNextPrev(t *testing.T) {
+	// Gaps in the numbers are stepped over in declaration order
+	assert.Equal(t, HTTPStatusCreated, HTTPStatusOK.Next())
+	assert.Equal(t, HTTPStatusNotFound, HTTPStatusCreated.Next())
+	assert.Equal(t, HTTPStatusCreated, HTTPStatusNotFound.Prev())
+
+	// Wraps around at both ends
+	assert.Equal(t, HTTPStatusOK, HTTPStatusError.Next())
+	assert.Equal(t, HTTPStatusError, HTTPStatusOK.Prev())
+
+	// Unknown values stay where they are
+	assert.Equal(t, HTTPStatus(999), HTTPStatus(999).Next())
+	assert.Equal(t, HTTPStatus(999), HTTPStatus(999).Prev())
+}
+
+func TestHTTPStatusIndex(t *testing.T) {
+	for i, x := range []HTTPStatus{HTTPStatusOK, HTTPStatusCreated, HTTPStatusNotFound, HTTPStatusError} {
+		assert.Equal(t, i, x.Index())
+
+		back, err := HTTPStatusFromIndex(i)
+		require.NoError(t, err)
+		assert.Equal(t, x, back)
+	}
+	assert.Equal(t, -1, HTTPStatus(999).Index())
+
+	for _, i := range []int{-1, 4} {
+		x, err := HTTPStatusFromIndex(i)
+		assert.ErrorIs(t, err, ErrInvalidHTTPStatus)
+		assert.Equal(t, HTTPStatus(0), x)
+	}
+}
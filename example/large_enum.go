@@ -0,0 +1,1254 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: example
+// Revision: example
+// Build Date: example
+// Built By: example
+
+//go:build example
+// +build example
+
+package example
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const (
+	LargeValue000 Large = "value000"
+	LargeValue001 Large = "value001"
+	LargeValue002 Large = "value002"
+	LargeValue003 Large = "value003"
+	LargeValue004 Large = "value004"
+	LargeValue005 Large = "value005"
+	LargeValue006 Large = "value006"
+	LargeValue007 Large = "value007"
+	LargeValue008 Large = "value008"
+	LargeValue009 Large = "value009"
+	LargeValue010 Large = "value010"
+	LargeValue011 Large = "value011"
+	LargeValue012 Large = "value012"
+	LargeValue013 Large = "value013"
+	LargeValue014 Large = "value014"
+	LargeValue015 Large = "value015"
+	LargeValue016 Large = "value016"
+	LargeValue017 Large = "value017"
+	LargeValue018 Large = "value018"
+	LargeValue019 Large = "value019"
+	LargeValue020 Large = "value020"
+	LargeValue021 Large = "value021"
+	LargeValue022 Large = "value022"
+	LargeValue023 Large = "value023"
+	LargeValue024 Large = "value024"
+	LargeValue025 Large = "value025"
+	LargeValue026 Large = "value026"
+	LargeValue027 Large = "value027"
+	LargeValue028 Large = "value028"
+	LargeValue029 Large = "value029"
+	LargeValue030 Large = "value030"
+	LargeValue031 Large = "value031"
+	LargeValue032 Large = "value032"
+	LargeValue033 Large = "value033"
+	LargeValue034 Large = "value034"
+	LargeValue035 Large = "value035"
+	LargeValue036 Large = "value036"
+	LargeValue037 Large = "value037"
+	LargeValue038 Large = "value038"
+	LargeValue039 Large = "value039"
+	LargeValue040 Large = "value040"
+	LargeValue041 Large = "value041"
+	LargeValue042 Large = "value042"
+	LargeValue043 Large = "value043"
+	LargeValue044 Large = "value044"
+	LargeValue045 Large = "value045"
+	LargeValue046 Large = "value046"
+	LargeValue047 Large = "value047"
+	LargeValue048 Large = "value048"
+	LargeValue049 Large = "value049"
+	LargeValue050 Large = "value050"
+	LargeValue051 Large = "value051"
+	LargeValue052 Large = "value052"
+	LargeValue053 Large = "value053"
+	LargeValue054 Large = "value054"
+	LargeValue055 Large = "value055"
+	LargeValue056 Large = "value056"
+	LargeValue057 Large = "value057"
+	LargeValue058 Large = "value058"
+	LargeValue059 Large = "value059"
+	LargeValue060 Large = "value060"
+	LargeValue061 Large = "value061"
+	LargeValue062 Large = "value062"
+	LargeValue063 Large = "value063"
+	LargeValue064 Large = "value064"
+	LargeValue065 Large = "value065"
+	LargeValue066 Large = "value066"
+	LargeValue067 Large = "value067"
+	LargeValue068 Large = "value068"
+	LargeValue069 Large = "value069"
+	LargeValue070 Large = "value070"
+	LargeValue071 Large = "value071"
+	LargeValue072 Large = "value072"
+	LargeValue073 Large = "value073"
+	LargeValue074 Large = "value074"
+	LargeValue075 Large = "value075"
+	LargeValue076 Large = "value076"
+	LargeValue077 Large = "value077"
+	LargeValue078 Large = "value078"
+	LargeValue079 Large = "value079"
+	LargeValue080 Large = "value080"
+	LargeValue081 Large = "value081"
+	LargeValue082 Large = "value082"
+	LargeValue083 Large = "value083"
+	LargeValue084 Large = "value084"
+	LargeValue085 Large = "value085"
+	LargeValue086 Large = "value086"
+	LargeValue087 Large = "value087"
+	LargeValue088 Large = "value088"
+	LargeValue089 Large = "value089"
+	LargeValue090 Large = "value090"
+	LargeValue091 Large = "value091"
+	LargeValue092 Large = "value092"
+	LargeValue093 Large = "value093"
+	LargeValue094 Large = "value094"
+	LargeValue095 Large = "value095"
+	LargeValue096 Large = "value096"
+	LargeValue097 Large = "value097"
+	LargeValue098 Large = "value098"
+	LargeValue099 Large = "value099"
+	LargeValue100 Large = "value100"
+	LargeValue101 Large = "value101"
+	LargeValue102 Large = "value102"
+	LargeValue103 Large = "value103"
+	LargeValue104 Large = "value104"
+	LargeValue105 Large = "value105"
+	LargeValue106 Large = "value106"
+	LargeValue107 Large = "value107"
+	LargeValue108 Large = "value108"
+	LargeValue109 Large = "value109"
+	LargeValue110 Large = "value110"
+	LargeValue111 Large = "value111"
+	LargeValue112 Large = "value112"
+	LargeValue113 Large = "value113"
+	LargeValue114 Large = "value114"
+	LargeValue115 Large = "value115"
+	LargeValue116 Large = "value116"
+	LargeValue117 Large = "value117"
+	LargeValue118 Large = "value118"
+	LargeValue119 Large = "value119"
+	LargeValue120 Large = "value120"
+	LargeValue121 Large = "value121"
+	LargeValue122 Large = "value122"
+	LargeValue123 Large = "value123"
+	LargeValue124 Large = "value124"
+	LargeValue125 Large = "value125"
+	LargeValue126 Large = "value126"
+	LargeValue127 Large = "value127"
+	LargeValue128 Large = "value128"
+	LargeValue129 Large = "value129"
+	LargeValue130 Large = "value130"
+	LargeValue131 Large = "value131"
+	LargeValue132 Large = "value132"
+	LargeValue133 Large = "value133"
+	LargeValue134 Large = "value134"
+	LargeValue135 Large = "value135"
+	LargeValue136 Large = "value136"
+	LargeValue137 Large = "value137"
+	LargeValue138 Large = "value138"
+	LargeValue139 Large = "value139"
+	LargeValue140 Large = "value140"
+	LargeValue141 Large = "value141"
+	LargeValue142 Large = "value142"
+	LargeValue143 Large = "value143"
+	LargeValue144 Large = "value144"
+	LargeValue145 Large = "value145"
+	LargeValue146 Large = "value146"
+	LargeValue147 Large = "value147"
+	LargeValue148 Large = "value148"
+	LargeValue149 Large = "value149"
+	LargeValue150 Large = "value150"
+	LargeValue151 Large = "value151"
+	LargeValue152 Large = "value152"
+	LargeValue153 Large = "value153"
+	LargeValue154 Large = "value154"
+	LargeValue155 Large = "value155"
+	LargeValue156 Large = "value156"
+	LargeValue157 Large = "value157"
+	LargeValue158 Large = "value158"
+	LargeValue159 Large = "value159"
+	LargeValue160 Large = "value160"
+	LargeValue161 Large = "value161"
+	LargeValue162 Large = "value162"
+	LargeValue163 Large = "value163"
+	LargeValue164 Large = "value164"
+	LargeValue165 Large = "value165"
+	LargeValue166 Large = "value166"
+	LargeValue167 Large = "value167"
+	LargeValue168 Large = "value168"
+	LargeValue169 Large = "value169"
+	LargeValue170 Large = "value170"
+	LargeValue171 Large = "value171"
+	LargeValue172 Large = "value172"
+	LargeValue173 Large = "value173"
+	LargeValue174 Large = "value174"
+	LargeValue175 Large = "value175"
+	LargeValue176 Large = "value176"
+	LargeValue177 Large = "value177"
+	LargeValue178 Large = "value178"
+	LargeValue179 Large = "value179"
+	LargeValue180 Large = "value180"
+	LargeValue181 Large = "value181"
+	LargeValue182 Large = "value182"
+	LargeValue183 Large = "value183"
+	LargeValue184 Large = "value184"
+	LargeValue185 Large = "value185"
+	LargeValue186 Large = "value186"
+	LargeValue187 Large = "value187"
+	LargeValue188 Large = "value188"
+	LargeValue189 Large = "value189"
+	LargeValue190 Large = "value190"
+	LargeValue191 Large = "value191"
+	LargeValue192 Large = "value192"
+	LargeValue193 Large = "value193"
+	LargeValue194 Large = "value194"
+	LargeValue195 Large = "value195"
+	LargeValue196 Large = "value196"
+	LargeValue197 Large = "value197"
+	LargeValue198 Large = "value198"
+	LargeValue199 Large = "value199"
+	LargeValue200 Large = "value200"
+	LargeValue201 Large = "value201"
+	LargeValue202 Large = "value202"
+	LargeValue203 Large = "value203"
+	LargeValue204 Large = "value204"
+	LargeValue205 Large = "value205"
+	LargeValue206 Large = "value206"
+	LargeValue207 Large = "value207"
+	LargeValue208 Large = "value208"
+	LargeValue209 Large = "value209"
+	LargeValue210 Large = "value210"
+	LargeValue211 Large = "value211"
+	LargeValue212 Large = "value212"
+	LargeValue213 Large = "value213"
+	LargeValue214 Large = "value214"
+	LargeValue215 Large = "value215"
+	LargeValue216 Large = "value216"
+	LargeValue217 Large = "value217"
+	LargeValue218 Large = "value218"
+	LargeValue219 Large = "value219"
+	LargeValue220 Large = "value220"
+	LargeValue221 Large = "value221"
+	LargeValue222 Large = "value222"
+	LargeValue223 Large = "value223"
+	LargeValue224 Large = "value224"
+	LargeValue225 Large = "value225"
+	LargeValue226 Large = "value226"
+	LargeValue227 Large = "value227"
+	LargeValue228 Large = "value228"
+	LargeValue229 Large = "value229"
+	LargeValue230 Large = "value230"
+	LargeValue231 Large = "value231"
+	LargeValue232 Large = "value232"
+	LargeValue233 Large = "value233"
+	LargeValue234 Large = "value234"
+	LargeValue235 Large = "value235"
+	LargeValue236 Large = "value236"
+	LargeValue237 Large = "value237"
+	LargeValue238 Large = "value238"
+	LargeValue239 Large = "value239"
+	LargeValue240 Large = "value240"
+	LargeValue241 Large = "value241"
+	LargeValue242 Large = "value242"
+	LargeValue243 Large = "value243"
+	LargeValue244 Large = "value244"
+	LargeValue245 Large = "value245"
+	LargeValue246 Large = "value246"
+	LargeValue247 Large = "value247"
+	LargeValue248 Large = "value248"
+	LargeValue249 Large = "value249"
+	LargeValue250 Large = "value250"
+	LargeValue251 Large = "value251"
+	LargeValue252 Large = "value252"
+	LargeValue253 Large = "value253"
+	LargeValue254 Large = "value254"
+	LargeValue255 Large = "value255"
+	LargeValue256 Large = "value256"
+	LargeValue257 Large = "value257"
+	LargeValue258 Large = "value258"
+	LargeValue259 Large = "value259"
+	LargeValue260 Large = "value260"
+	LargeValue261 Large = "value261"
+	LargeValue262 Large = "value262"
+	LargeValue263 Large = "value263"
+	LargeValue264 Large = "value264"
+	LargeValue265 Large = "value265"
+	LargeValue266 Large = "value266"
+	LargeValue267 Large = "value267"
+	LargeValue268 Large = "value268"
+	LargeValue269 Large = "value269"
+	LargeValue270 Large = "value270"
+	LargeValue271 Large = "value271"
+	LargeValue272 Large = "value272"
+	LargeValue273 Large = "value273"
+	LargeValue274 Large = "value274"
+	LargeValue275 Large = "value275"
+	LargeValue276 Large = "value276"
+	LargeValue277 Large = "value277"
+	LargeValue278 Large = "value278"
+	LargeValue279 Large = "value279"
+	LargeValue280 Large = "value280"
+	LargeValue281 Large = "value281"
+	LargeValue282 Large = "value282"
+	LargeValue283 Large = "value283"
+	LargeValue284 Large = "value284"
+	LargeValue285 Large = "value285"
+	LargeValue286 Large = "value286"
+	LargeValue287 Large = "value287"
+	LargeValue288 Large = "value288"
+	LargeValue289 Large = "value289"
+	LargeValue290 Large = "value290"
+	LargeValue291 Large = "value291"
+	LargeValue292 Large = "value292"
+	LargeValue293 Large = "value293"
+	LargeValue294 Large = "value294"
+	LargeValue295 Large = "value295"
+	LargeValue296 Large = "value296"
+	LargeValue297 Large = "value297"
+	LargeValue298 Large = "value298"
+	LargeValue299 Large = "value299"
+	LargeValue300 Large = "value300"
+	LargeValue301 Large = "value301"
+	LargeValue302 Large = "value302"
+	LargeValue303 Large = "value303"
+	LargeValue304 Large = "value304"
+	LargeValue305 Large = "value305"
+	LargeValue306 Large = "value306"
+	LargeValue307 Large = "value307"
+	LargeValue308 Large = "value308"
+	LargeValue309 Large = "value309"
+	LargeValue310 Large = "value310"
+	LargeValue311 Large = "value311"
+	LargeValue312 Large = "value312"
+	LargeValue313 Large = "value313"
+	LargeValue314 Large = "value314"
+	LargeValue315 Large = "value315"
+	LargeValue316 Large = "value316"
+	LargeValue317 Large = "value317"
+	LargeValue318 Large = "value318"
+	LargeValue319 Large = "value319"
+	LargeValue320 Large = "value320"
+	LargeValue321 Large = "value321"
+	LargeValue322 Large = "value322"
+	LargeValue323 Large = "value323"
+	LargeValue324 Large = "value324"
+	LargeValue325 Large = "value325"
+	LargeValue326 Large = "value326"
+	LargeValue327 Large = "value327"
+	LargeValue328 Large = "value328"
+	LargeValue329 Large = "value329"
+	LargeValue330 Large = "value330"
+	LargeValue331 Large = "value331"
+	LargeValue332 Large = "value332"
+	LargeValue333 Large = "value333"
+	LargeValue334 Large = "value334"
+	LargeValue335 Large = "value335"
+	LargeValue336 Large = "value336"
+	LargeValue337 Large = "value337"
+	LargeValue338 Large = "value338"
+	LargeValue339 Large = "value339"
+	LargeValue340 Large = "value340"
+	LargeValue341 Large = "value341"
+	LargeValue342 Large = "value342"
+	LargeValue343 Large = "value343"
+	LargeValue344 Large = "value344"
+	LargeValue345 Large = "value345"
+	LargeValue346 Large = "value346"
+	LargeValue347 Large = "value347"
+	LargeValue348 Large = "value348"
+	LargeValue349 Large = "value349"
+	LargeValue350 Large = "value350"
+	LargeValue351 Large = "value351"
+	LargeValue352 Large = "value352"
+	LargeValue353 Large = "value353"
+	LargeValue354 Large = "value354"
+	LargeValue355 Large = "value355"
+	LargeValue356 Large = "value356"
+	LargeValue357 Large = "value357"
+	LargeValue358 Large = "value358"
+	LargeValue359 Large = "value359"
+	LargeValue360 Large = "value360"
+	LargeValue361 Large = "value361"
+	LargeValue362 Large = "value362"
+	LargeValue363 Large = "value363"
+	LargeValue364 Large = "value364"
+	LargeValue365 Large = "value365"
+	LargeValue366 Large = "value366"
+	LargeValue367 Large = "value367"
+	LargeValue368 Large = "value368"
+	LargeValue369 Large = "value369"
+	LargeValue370 Large = "value370"
+	LargeValue371 Large = "value371"
+	LargeValue372 Large = "value372"
+	LargeValue373 Large = "value373"
+	LargeValue374 Large = "value374"
+	LargeValue375 Large = "value375"
+	LargeValue376 Large = "value376"
+	LargeValue377 Large = "value377"
+	LargeValue378 Large = "value378"
+	LargeValue379 Large = "value379"
+	LargeValue380 Large = "value380"
+	LargeValue381 Large = "value381"
+	LargeValue382 Large = "value382"
+	LargeValue383 Large = "value383"
+	LargeValue384 Large = "value384"
+	LargeValue385 Large = "value385"
+	LargeValue386 Large = "value386"
+	LargeValue387 Large = "value387"
+	LargeValue388 Large = "value388"
+	LargeValue389 Large = "value389"
+	LargeValue390 Large = "value390"
+	LargeValue391 Large = "value391"
+	LargeValue392 Large = "value392"
+	LargeValue393 Large = "value393"
+	LargeValue394 Large = "value394"
+	LargeValue395 Large = "value395"
+	LargeValue396 Large = "value396"
+	LargeValue397 Large = "value397"
+	LargeValue398 Large = "value398"
+	LargeValue399 Large = "value399"
+)
+
+var ErrInvalidLarge = errors.New("not a valid Large")
+
+// String implements the Stringer interface.
+func (x Large) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x Large) IsValid() bool {
+	_, err := ParseLarge(string(x))
+	return err == nil
+}
+
+var _LargeValue = map[string]Large{
+	"value000": LargeValue000,
+	"value001": LargeValue001,
+	"value002": LargeValue002,
+	"value003": LargeValue003,
+	"value004": LargeValue004,
+	"value005": LargeValue005,
+	"value006": LargeValue006,
+	"value007": LargeValue007,
+	"value008": LargeValue008,
+	"value009": LargeValue009,
+	"value010": LargeValue010,
+	"value011": LargeValue011,
+	"value012": LargeValue012,
+	"value013": LargeValue013,
+	"value014": LargeValue014,
+	"value015": LargeValue015,
+	"value016": LargeValue016,
+	"value017": LargeValue017,
+	"value018": LargeValue018,
+	"value019": LargeValue019,
+	"value020": LargeValue020,
+	"value021": LargeValue021,
+	"value022": LargeValue022,
+	"value023": LargeValue023,
+	"value024": LargeValue024,
+	"value025": LargeValue025,
+	"value026": LargeValue026,
+	"value027": LargeValue027,
+	"value028": LargeValue028,
+	"value029": LargeValue029,
+	"value030": LargeValue030,
+	"value031": LargeValue031,
+	"value032": LargeValue032,
+	"value033": LargeValue033,
+	"value034": LargeValue034,
+	"value035": LargeValue035,
+	"value036": LargeValue036,
+	"value037": LargeValue037,
+	"value038": LargeValue038,
+	"value039": LargeValue039,
+	"value040": LargeValue040,
+	"value041": LargeValue041,
+	"value042": LargeValue042,
+	"value043": LargeValue043,
+	"value044": LargeValue044,
+	"value045": LargeValue045,
+	"value046": LargeValue046,
+	"value047": LargeValue047,
+	"value048": LargeValue048,
+	"value049": LargeValue049,
+	"value050": LargeValue050,
+	"value051": LargeValue051,
+	"value052": LargeValue052,
+	"value053": LargeValue053,
+	"value054": LargeValue054,
+	"value055": LargeValue055,
+	"value056": LargeValue056,
+	"value057": LargeValue057,
+	"value058": LargeValue058,
+	"value059": LargeValue059,
+	"value060": LargeValue060,
+	"value061": LargeValue061,
+	"value062": LargeValue062,
+	"value063": LargeValue063,
+	"value064": LargeValue064,
+	"value065": LargeValue065,
+	"value066": LargeValue066,
+	"value067": LargeValue067,
+	"value068": LargeValue068,
+	"value069": LargeValue069,
+	"value070": LargeValue070,
+	"value071": LargeValue071,
+	"value072": LargeValue072,
+	"value073": LargeValue073,
+	"value074": LargeValue074,
+	"value075": LargeValue075,
+	"value076": LargeValue076,
+	"value077": LargeValue077,
+	"value078": LargeValue078,
+	"value079": LargeValue079,
+	"value080": LargeValue080,
+	"value081": LargeValue081,
+	"value082": LargeValue082,
+	"value083": LargeValue083,
+	"value084": LargeValue084,
+	"value085": LargeValue085,
+	"value086": LargeValue086,
+	"value087": LargeValue087,
+	"value088": LargeValue088,
+	"value089": LargeValue089,
+	"value090": LargeValue090,
+	"value091": LargeValue091,
+	"value092": LargeValue092,
+	"value093": LargeValue093,
+	"value094": LargeValue094,
+	"value095": LargeValue095,
+	"value096": LargeValue096,
+	"value097": LargeValue097,
+	"value098": LargeValue098,
+	"value099": LargeValue099,
+	"value100": LargeValue100,
+	"value101": LargeValue101,
+	"value102": LargeValue102,
+	"value103": LargeValue103,
+	"value104": LargeValue104,
+	"value105": LargeValue105,
+	"value106": LargeValue106,
+	"value107": LargeValue107,
+	"value108": LargeValue108,
+	"value109": LargeValue109,
+	"value110": LargeValue110,
+	"value111": LargeValue111,
+	"value112": LargeValue112,
+	"value113": LargeValue113,
+	"value114": LargeValue114,
+	"value115": LargeValue115,
+	"value116": LargeValue116,
+	"value117": LargeValue117,
+	"value118": LargeValue118,
+	"value119": LargeValue119,
+	"value120": LargeValue120,
+	"value121": LargeValue121,
+	"value122": LargeValue122,
+	"value123": LargeValue123,
+	"value124": LargeValue124,
+	"value125": LargeValue125,
+	"value126": LargeValue126,
+	"value127": LargeValue127,
+	"value128": LargeValue128,
+	"value129": LargeValue129,
+	"value130": LargeValue130,
+	"value131": LargeValue131,
+	"value132": LargeValue132,
+	"value133": LargeValue133,
+	"value134": LargeValue134,
+	"value135": LargeValue135,
+	"value136": LargeValue136,
+	"value137": LargeValue137,
+	"value138": LargeValue138,
+	"value139": LargeValue139,
+	"value140": LargeValue140,
+	"value141": LargeValue141,
+	"value142": LargeValue142,
+	"value143": LargeValue143,
+	"value144": LargeValue144,
+	"value145": LargeValue145,
+	"value146": LargeValue146,
+	"value147": LargeValue147,
+	"value148": LargeValue148,
+	"value149": LargeValue149,
+	"value150": LargeValue150,
+	"value151": LargeValue151,
+	"value152": LargeValue152,
+	"value153": LargeValue153,
+	"value154": LargeValue154,
+	"value155": LargeValue155,
+	"value156": LargeValue156,
+	"value157": LargeValue157,
+	"value158": LargeValue158,
+	"value159": LargeValue159,
+	"value160": LargeValue160,
+	"value161": LargeValue161,
+	"value162": LargeValue162,
+	"value163": LargeValue163,
+	"value164": LargeValue164,
+	"value165": LargeValue165,
+	"value166": LargeValue166,
+	"value167": LargeValue167,
+	"value168": LargeValue168,
+	"value169": LargeValue169,
+	"value170": LargeValue170,
+	"value171": LargeValue171,
+	"value172": LargeValue172,
+	"value173": LargeValue173,
+	"value174": LargeValue174,
+	"value175": LargeValue175,
+	"value176": LargeValue176,
+	"value177": LargeValue177,
+	"value178": LargeValue178,
+	"value179": LargeValue179,
+	"value180": LargeValue180,
+	"value181": LargeValue181,
+	"value182": LargeValue182,
+	"value183": LargeValue183,
+	"value184": LargeValue184,
+	"value185": LargeValue185,
+	"value186": LargeValue186,
+	"value187": LargeValue187,
+	"value188": LargeValue188,
+	"value189": LargeValue189,
+	"value190": LargeValue190,
+	"value191": LargeValue191,
+	"value192": LargeValue192,
+	"value193": LargeValue193,
+	"value194": LargeValue194,
+	"value195": LargeValue195,
+	"value196": LargeValue196,
+	"value197": LargeValue197,
+	"value198": LargeValue198,
+	"value199": LargeValue199,
+	"value200": LargeValue200,
+	"value201": LargeValue201,
+	"value202": LargeValue202,
+	"value203": LargeValue203,
+	"value204": LargeValue204,
+	"value205": LargeValue205,
+	"value206": LargeValue206,
+	"value207": LargeValue207,
+	"value208": LargeValue208,
+	"value209": LargeValue209,
+	"value210": LargeValue210,
+	"value211": LargeValue211,
+	"value212": LargeValue212,
+	"value213": LargeValue213,
+	"value214": LargeValue214,
+	"value215": LargeValue215,
+	"value216": LargeValue216,
+	"value217": LargeValue217,
+	"value218": LargeValue218,
+	"value219": LargeValue219,
+	"value220": LargeValue220,
+	"value221": LargeValue221,
+	"value222": LargeValue222,
+	"value223": LargeValue223,
+	"value224": LargeValue224,
+	"value225": LargeValue225,
+	"value226": LargeValue226,
+	"value227": LargeValue227,
+	"value228": LargeValue228,
+	"value229": LargeValue229,
+	"value230": LargeValue230,
+	"value231": LargeValue231,
+	"value232": LargeValue232,
+	"value233": LargeValue233,
+	"value234": LargeValue234,
+	"value235": LargeValue235,
+	"value236": LargeValue236,
+	"value237": LargeValue237,
+	"value238": LargeValue238,
+	"value239": LargeValue239,
+	"value240": LargeValue240,
+	"value241": LargeValue241,
+	"value242": LargeValue242,
+	"value243": LargeValue243,
+	"value244": LargeValue244,
+	"value245": LargeValue245,
+	"value246": LargeValue246,
+	"value247": LargeValue247,
+	"value248": LargeValue248,
+	"value249": LargeValue249,
+	"value250": LargeValue250,
+	"value251": LargeValue251,
+	"value252": LargeValue252,
+	"value253": LargeValue253,
+	"value254": LargeValue254,
+	"value255": LargeValue255,
+	"value256": LargeValue256,
+	"value257": LargeValue257,
+	"value258": LargeValue258,
+	"value259": LargeValue259,
+	"value260": LargeValue260,
+	"value261": LargeValue261,
+	"value262": LargeValue262,
+	"value263": LargeValue263,
+	"value264": LargeValue264,
+	"value265": LargeValue265,
+	"value266": LargeValue266,
+	"value267": LargeValue267,
+	"value268": LargeValue268,
+	"value269": LargeValue269,
+	"value270": LargeValue270,
+	"value271": LargeValue271,
+	"value272": LargeValue272,
+	"value273": LargeValue273,
+	"value274": LargeValue274,
+	"value275": LargeValue275,
+	"value276": LargeValue276,
+	"value277": LargeValue277,
+	"value278": LargeValue278,
+	"value279": LargeValue279,
+	"value280": LargeValue280,
+	"value281": LargeValue281,
+	"value282": LargeValue282,
+	"value283": LargeValue283,
+	"value284": LargeValue284,
+	"value285": LargeValue285,
+	"value286": LargeValue286,
+	"value287": LargeValue287,
+	"value288": LargeValue288,
+	"value289": LargeValue289,
+	"value290": LargeValue290,
+	"value291": LargeValue291,
+	"value292": LargeValue292,
+	"value293": LargeValue293,
+	"value294": LargeValue294,
+	"value295": LargeValue295,
+	"value296": LargeValue296,
+	"value297": LargeValue297,
+	"value298": LargeValue298,
+	"value299": LargeValue299,
+	"value300": LargeValue300,
+	"value301": LargeValue301,
+	"value302": LargeValue302,
+	"value303": LargeValue303,
+	"value304": LargeValue304,
+	"value305": LargeValue305,
+	"value306": LargeValue306,
+	"value307": LargeValue307,
+	"value308": LargeValue308,
+	"value309": LargeValue309,
+	"value310": LargeValue310,
+	"value311": LargeValue311,
+	"value312": LargeValue312,
+	"value313": LargeValue313,
+	"value314": LargeValue314,
+	"value315": LargeValue315,
+	"value316": LargeValue316,
+	"value317": LargeValue317,
+	"value318": LargeValue318,
+	"value319": LargeValue319,
+	"value320": LargeValue320,
+	"value321": LargeValue321,
+	"value322": LargeValue322,
+	"value323": LargeValue323,
+	"value324": LargeValue324,
+	"value325": LargeValue325,
+	"value326": LargeValue326,
+	"value327": LargeValue327,
+	"value328": LargeValue328,
+	"value329": LargeValue329,
+	"value330": LargeValue330,
+	"value331": LargeValue331,
+	"value332": LargeValue332,
+	"value333": LargeValue333,
+	"value334": LargeValue334,
+	"value335": LargeValue335,
+	"value336": LargeValue336,
+	"value337": LargeValue337,
+	"value338": LargeValue338,
+	"value339": LargeValue339,
+	"value340": LargeValue340,
+	"value341": LargeValue341,
+	"value342": LargeValue342,
+	"value343": LargeValue343,
+	"value344": LargeValue344,
+	"value345": LargeValue345,
+	"value346": LargeValue346,
+	"value347": LargeValue347,
+	"value348": LargeValue348,
+	"value349": LargeValue349,
+	"value350": LargeValue350,
+	"value351": LargeValue351,
+	"value352": LargeValue352,
+	"value353": LargeValue353,
+	"value354": LargeValue354,
+	"value355": LargeValue355,
+	"value356": LargeValue356,
+	"value357": LargeValue357,
+	"value358": LargeValue358,
+	"value359": LargeValue359,
+	"value360": LargeValue360,
+	"value361": LargeValue361,
+	"value362": LargeValue362,
+	"value363": LargeValue363,
+	"value364": LargeValue364,
+	"value365": LargeValue365,
+	"value366": LargeValue366,
+	"value367": LargeValue367,
+	"value368": LargeValue368,
+	"value369": LargeValue369,
+	"value370": LargeValue370,
+	"value371": LargeValue371,
+	"value372": LargeValue372,
+	"value373": LargeValue373,
+	"value374": LargeValue374,
+	"value375": LargeValue375,
+	"value376": LargeValue376,
+	"value377": LargeValue377,
+	"value378": LargeValue378,
+	"value379": LargeValue379,
+	"value380": LargeValue380,
+	"value381": LargeValue381,
+	"value382": LargeValue382,
+	"value383": LargeValue383,
+	"value384": LargeValue384,
+	"value385": LargeValue385,
+	"value386": LargeValue386,
+	"value387": LargeValue387,
+	"value388": LargeValue388,
+	"value389": LargeValue389,
+	"value390": LargeValue390,
+	"value391": LargeValue391,
+	"value392": LargeValue392,
+	"value393": LargeValue393,
+	"value394": LargeValue394,
+	"value395": LargeValue395,
+	"value396": LargeValue396,
+	"value397": LargeValue397,
+	"value398": LargeValue398,
+	"value399": LargeValue399,
+}
+
+var _LargeLowerValue = map[string]Large{
+	"value000": LargeValue000,
+	"value001": LargeValue001,
+	"value002": LargeValue002,
+	"value003": LargeValue003,
+	"value004": LargeValue004,
+	"value005": LargeValue005,
+	"value006": LargeValue006,
+	"value007": LargeValue007,
+	"value008": LargeValue008,
+	"value009": LargeValue009,
+	"value010": LargeValue010,
+	"value011": LargeValue011,
+	"value012": LargeValue012,
+	"value013": LargeValue013,
+	"value014": LargeValue014,
+	"value015": LargeValue015,
+	"value016": LargeValue016,
+	"value017": LargeValue017,
+	"value018": LargeValue018,
+	"value019": LargeValue019,
+	"value020": LargeValue020,
+	"value021": LargeValue021,
+	"value022": LargeValue022,
+	"value023": LargeValue023,
+	"value024": LargeValue024,
+	"value025": LargeValue025,
+	"value026": LargeValue026,
+	"value027": LargeValue027,
+	"value028": LargeValue028,
+	"value029": LargeValue029,
+	"value030": LargeValue030,
+	"value031": LargeValue031,
+	"value032": LargeValue032,
+	"value033": LargeValue033,
+	"value034": LargeValue034,
+	"value035": LargeValue035,
+	"value036": LargeValue036,
+	"value037": LargeValue037,
+	"value038": LargeValue038,
+	"value039": LargeValue039,
+	"value040": LargeValue040,
+	"value041": LargeValue041,
+	"value042": LargeValue042,
+	"value043": LargeValue043,
+	"value044": LargeValue044,
+	"value045": LargeValue045,
+	"value046": LargeValue046,
+	"value047": LargeValue047,
+	"value048": LargeValue048,
+	"value049": LargeValue049,
+	"value050": LargeValue050,
+	"value051": LargeValue051,
+	"value052": LargeValue052,
+	"value053": LargeValue053,
+	"value054": LargeValue054,
+	"value055": LargeValue055,
+	"value056": LargeValue056,
+	"value057": LargeValue057,
+	"value058": LargeValue058,
+	"value059": LargeValue059,
+	"value060": LargeValue060,
+	"value061": LargeValue061,
+	"value062": LargeValue062,
+	"value063": LargeValue063,
+	"value064": LargeValue064,
+	"value065": LargeValue065,
+	"value066": LargeValue066,
+	"value067": LargeValue067,
+	"value068": LargeValue068,
+	"value069": LargeValue069,
+	"value070": LargeValue070,
+	"value071": LargeValue071,
+	"value072": LargeValue072,
+	"value073": LargeValue073,
+	"value074": LargeValue074,
+	"value075": LargeValue075,
+	"value076": LargeValue076,
+	"value077": LargeValue077,
+	"value078": LargeValue078,
+	"value079": LargeValue079,
+	"value080": LargeValue080,
+	"value081": LargeValue081,
+	"value082": LargeValue082,
+	"value083": LargeValue083,
+	"value084": LargeValue084,
+	"value085": LargeValue085,
+	"value086": LargeValue086,
+	"value087": LargeValue087,
+	"value088": LargeValue088,
+	"value089": LargeValue089,
+	"value090": LargeValue090,
+	"value091": LargeValue091,
+	"value092": LargeValue092,
+	"value093": LargeValue093,
+	"value094": LargeValue094,
+	"value095": LargeValue095,
+	"value096": LargeValue096,
+	"value097": LargeValue097,
+	"value098": LargeValue098,
+	"value099": LargeValue099,
+	"value100": LargeValue100,
+	"value101": LargeValue101,
+	"value102": LargeValue102,
+	"value103": LargeValue103,
+	"value104": LargeValue104,
+	"value105": LargeValue105,
+	"value106": LargeValue106,
+	"value107": LargeValue107,
+	"value108": LargeValue108,
+	"value109": LargeValue109,
+	"value110": LargeValue110,
+	"value111": LargeValue111,
+	"value112": LargeValue112,
+	"value113": LargeValue113,
+	"value114": LargeValue114,
+	"value115": LargeValue115,
+	"value116": LargeValue116,
+	"value117": LargeValue117,
+	"value118": LargeValue118,
+	"value119": LargeValue119,
+	"value120": LargeValue120,
+	"value121": LargeValue121,
+	"value122": LargeValue122,
+	"value123": LargeValue123,
+	"value124": LargeValue124,
+	"value125": LargeValue125,
+	"value126": LargeValue126,
+	"value127": LargeValue127,
+	"value128": LargeValue128,
+	"value129": LargeValue129,
+	"value130": LargeValue130,
+	"value131": LargeValue131,
+	"value132": LargeValue132,
+	"value133": LargeValue133,
+	"value134": LargeValue134,
+	"value135": LargeValue135,
+	"value136": LargeValue136,
+	"value137": LargeValue137,
+	"value138": LargeValue138,
+	"value139": LargeValue139,
+	"value140": LargeValue140,
+	"value141": LargeValue141,
+	"value142": LargeValue142,
+	"value143": LargeValue143,
+	"value144": LargeValue144,
+	"value145": LargeValue145,
+	"value146": LargeValue146,
+	"value147": LargeValue147,
+	"value148": LargeValue148,
+	"value149": LargeValue149,
+	"value150": LargeValue150,
+	"value151": LargeValue151,
+	"value152": LargeValue152,
+	"value153": LargeValue153,
+	"value154": LargeValue154,
+	"value155": LargeValue155,
+	"value156": LargeValue156,
+	"value157": LargeValue157,
+	"value158": LargeValue158,
+	"value159": LargeValue159,
+	"value160": LargeValue160,
+	"value161": LargeValue161,
+	"value162": LargeValue162,
+	"value163": LargeValue163,
+	"value164": LargeValue164,
+	"value165": LargeValue165,
+	"value166": LargeValue166,
+	"value167": LargeValue167,
+	"value168": LargeValue168,
+	"value169": LargeValue169,
+	"value170": LargeValue170,
+	"value171": LargeValue171,
+	"value172": LargeValue172,
+	"value173": LargeValue173,
+	"value174": LargeValue174,
+	"value175": LargeValue175,
+	"value176": LargeValue176,
+	"value177": LargeValue177,
+	"value178": LargeValue178,
+	"value179": LargeValue179,
+	"value180": LargeValue180,
+	"value181": LargeValue181,
+	"value182": LargeValue182,
+	"value183": LargeValue183,
+	"value184": LargeValue184,
+	"value185": LargeValue185,
+	"value186": LargeValue186,
+	"value187": LargeValue187,
+	"value188": LargeValue188,
+	"value189": LargeValue189,
+	"value190": LargeValue190,
+	"value191": LargeValue191,
+	"value192": LargeValue192,
+	"value193": LargeValue193,
+	"value194": LargeValue194,
+	"value195": LargeValue195,
+	"value196": LargeValue196,
+	"value197": LargeValue197,
+	"value198": LargeValue198,
+	"value199": LargeValue199,
+	"value200": LargeValue200,
+	"value201": LargeValue201,
+	"value202": LargeValue202,
+	"value203": LargeValue203,
+	"value204": LargeValue204,
+	"value205": LargeValue205,
+	"value206": LargeValue206,
+	"value207": LargeValue207,
+	"value208": LargeValue208,
+	"value209": LargeValue209,
+	"value210": LargeValue210,
+	"value211": LargeValue211,
+	"value212": LargeValue212,
+	"value213": LargeValue213,
+	"value214": LargeValue214,
+	"value215": LargeValue215,
+	"value216": LargeValue216,
+	"value217": LargeValue217,
+	"value218": LargeValue218,
+	"value219": LargeValue219,
+	"value220": LargeValue220,
+	"value221": LargeValue221,
+	"value222": LargeValue222,
+	"value223": LargeValue223,
+	"value224": LargeValue224,
+	"value225": LargeValue225,
+	"value226": LargeValue226,
+	"value227": LargeValue227,
+	"value228": LargeValue228,
+	"value229": LargeValue229,
+	"value230": LargeValue230,
+	"value231": LargeValue231,
+	"value232": LargeValue232,
+	"value233": LargeValue233,
+	"value234": LargeValue234,
+	"value235": LargeValue235,
+	"value236": LargeValue236,
+	"value237": LargeValue237,
+	"value238": LargeValue238,
+	"value239": LargeValue239,
+	"value240": LargeValue240,
+	"value241": LargeValue241,
+	"value242": LargeValue242,
+	"value243": LargeValue243,
+	"value244": LargeValue244,
+	"value245": LargeValue245,
+	"value246": LargeValue246,
+	"value247": LargeValue247,
+	"value248": LargeValue248,
+	"value249": LargeValue249,
+	"value250": LargeValue250,
+	"value251": LargeValue251,
+	"value252": LargeValue252,
+	"value253": LargeValue253,
+	"value254": LargeValue254,
+	"value255": LargeValue255,
+	"value256": LargeValue256,
+	"value257": LargeValue257,
+	"value258": LargeValue258,
+	"value259": LargeValue259,
+	"value260": LargeValue260,
+	"value261": LargeValue261,
+	"value262": LargeValue262,
+	"value263": LargeValue263,
+	"value264": LargeValue264,
+	"value265": LargeValue265,
+	"value266": LargeValue266,
+	"value267": LargeValue267,
+	"value268": LargeValue268,
+	"value269": LargeValue269,
+	"value270": LargeValue270,
+	"value271": LargeValue271,
+	"value272": LargeValue272,
+	"value273": LargeValue273,
+	"value274": LargeValue274,
+	"value275": LargeValue275,
+	"value276": LargeValue276,
+	"value277": LargeValue277,
+	"value278": LargeValue278,
+	"value279": LargeValue279,
+	"value280": LargeValue280,
+	"value281": LargeValue281,
+	"value282": LargeValue282,
+	"value283": LargeValue283,
+	"value284": LargeValue284,
+	"value285": LargeValue285,
+	"value286": LargeValue286,
+	"value287": LargeValue287,
+	"value288": LargeValue288,
+	"value289": LargeValue289,
+	"value290": LargeValue290,
+	"value291": LargeValue291,
+	"value292": LargeValue292,
+	"value293": LargeValue293,
+	"value294": LargeValue294,
+	"value295": LargeValue295,
+	"value296": LargeValue296,
+	"value297": LargeValue297,
+	"value298": LargeValue298,
+	"value299": LargeValue299,
+	"value300": LargeValue300,
+	"value301": LargeValue301,
+	"value302": LargeValue302,
+	"value303": LargeValue303,
+	"value304": LargeValue304,
+	"value305": LargeValue305,
+	"value306": LargeValue306,
+	"value307": LargeValue307,
+	"value308": LargeValue308,
+	"value309": LargeValue309,
+	"value310": LargeValue310,
+	"value311": LargeValue311,
+	"value312": LargeValue312,
+	"value313": LargeValue313,
+	"value314": LargeValue314,
+	"value315": LargeValue315,
+	"value316": LargeValue316,
+	"value317": LargeValue317,
+	"value318": LargeValue318,
+	"value319": LargeValue319,
+	"value320": LargeValue320,
+	"value321": LargeValue321,
+	"value322": LargeValue322,
+	"value323": LargeValue323,
+	"value324": LargeValue324,
+	"value325": LargeValue325,
+	"value326": LargeValue326,
+	"value327": LargeValue327,
+	"value328": LargeValue328,
+	"value329": LargeValue329,
+	"value330": LargeValue330,
+	"value331": LargeValue331,
+	"value332": LargeValue332,
+	"value333": LargeValue333,
+	"value334": LargeValue334,
+	"value335": LargeValue335,
+	"value336": LargeValue336,
+	"value337": LargeValue337,
+	"value338": LargeValue338,
+	"value339": LargeValue339,
+	"value340": LargeValue340,
+	"value341": LargeValue341,
+	"value342": LargeValue342,
+	"value343": LargeValue343,
+	"value344": LargeValue344,
+	"value345": LargeValue345,
+	"value346": LargeValue346,
+	"value347": LargeValue347,
+	"value348": LargeValue348,
+	"value349": LargeValue349,
+	"value350": LargeValue350,
+	"value351": LargeValue351,
+	"value352": LargeValue352,
+	"value353": LargeValue353,
+	"value354": LargeValue354,
+	"value355": LargeValue355,
+	"value356": LargeValue356,
+	"value357": LargeValue357,
+	"value358": LargeValue358,
+	"value359": LargeValue359,
+	"value360": LargeValue360,
+	"value361": LargeValue361,
+	"value362": LargeValue362,
+	"value363": LargeValue363,
+	"value364": LargeValue364,
+	"value365": LargeValue365,
+	"value366": LargeValue366,
+	"value367": LargeValue367,
+	"value368": LargeValue368,
+	"value369": LargeValue369,
+	"value370": LargeValue370,
+	"value371": LargeValue371,
+	"value372": LargeValue372,
+	"value373": LargeValue373,
+	"value374": LargeValue374,
+	"value375": LargeValue375,
+	"value376": LargeValue376,
+	"value377": LargeValue377,
+	"value378": LargeValue378,
+	"value379": LargeValue379,
+	"value380": LargeValue380,
+	"value381": LargeValue381,
+	"value382": LargeValue382,
+	"value383": LargeValue383,
+	"value384": LargeValue384,
+	"value385": LargeValue385,
+	"value386": LargeValue386,
+	"value387": LargeValue387,
+	"value388": LargeValue388,
+	"value389": LargeValue389,
+	"value390": LargeValue390,
+	"value391": LargeValue391,
+	"value392": LargeValue392,
+	"value393": LargeValue393,
+	"value394": LargeValue394,
+	"value395": LargeValue395,
+	"value396": LargeValue396,
+	"value397": LargeValue397,
+	"value398": LargeValue398,
+	"value399": LargeValue399,
+}
+
+// ParseLarge attempts to convert a string to a Large.
+func ParseLarge(name string) (Large, error) {
+	if x, ok := _LargeValue[name]; ok {
+		return x, nil
+	}
+	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of lowercasing a string if we don't need to.
+	// Inputs too long to lowercase into a name are not normalized at all.
+	if len(name) <= 32 {
+		if x, ok := _LargeLowerValue[strings.ToLower(name)]; ok {
+			return x, nil
+		}
+	}
+	return Large(""), fmt.Errorf("%s is %w", name, ErrInvalidLarge)
+}
@@ -0,0 +1,46 @@
+//go:build example
+// +build example
+
+package example
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLargeParse(t *testing.T) {
+	parsed, err := ParseLarge("value000")
+	assert.NoError(t, err)
+	assert.Equal(t, LargeValue000, parsed)
+
+	parsed, err = ParseLarge("VALUE399")
+	assert.NoError(t, err)
+	assert.Equal(t, LargeValue399, parsed)
+
+	_, err = ParseLarge("value400")
+	assert.EqualError(t, err, "value400 is not a valid Large")
+}
+
+// BenchmarkLargeParse shows the map based lookup of Parse doesn't depend on the position
+// of the value in the enum, compare with BenchmarkAnnotationParse.
+func BenchmarkLargeParse(b *testing.B) {
+	knownItems := []string{
+		"value000",
+		"value200",
+		"value399",
+		"VALUE399",
+	}
+
+	var err error
+	for _, item := range knownItems {
+		b.Run(item, func(b *testing.B) {
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				_, err = ParseLarge(item)
+				assert.NoError(b, err)
+			}
+		})
+	}
+}
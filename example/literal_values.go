@@ -0,0 +1,11 @@
+//go:generate ../bin/go-enum -b example
+
+package example
+
+// Flag values are written as hexadecimal, binary and octal literals.
+// ENUM(a=0x01, b=0x02, c=0b0100, d=0o10)
+type Flag int
+
+// Register maps hardware registers to their hexadecimal addresses.
+// ENUM(status=0x10, control=0x14, data=0x1C)
+type Register uint16
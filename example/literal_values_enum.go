@@ -0,0 +1,115 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: example
+// Revision: example
+// Build Date: example
+// Built By: example
+
+//go:build example
+// +build example
+
+package example
+
+import (
+	"errors"
+	"fmt"
+)
+
+const (
+	// FlagA is a Flag of type A.
+	FlagA Flag = iota + 1
+	// FlagB is a Flag of type B.
+	FlagB
+	// FlagC is a Flag of type C.
+	FlagC Flag = iota + 2
+	// FlagD is a Flag of type D.
+	FlagD Flag = iota + 5
+)
+
+var ErrInvalidFlag = errors.New("not a valid Flag")
+
+const _FlagName = "abcd"
+
+var _FlagMap = map[Flag]string{
+	FlagA: _FlagName[0:1],
+	FlagB: _FlagName[1:2],
+	FlagC: _FlagName[2:3],
+	FlagD: _FlagName[3:4],
+}
+
+// String implements the Stringer interface.
+func (x Flag) String() string {
+	if str, ok := _FlagMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("Flag(%d)", x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x Flag) IsValid() bool {
+	_, ok := _FlagMap[x]
+	return ok
+}
+
+var _FlagValue = map[string]Flag{
+	_FlagName[0:1]: FlagA,
+	_FlagName[1:2]: FlagB,
+	_FlagName[2:3]: FlagC,
+	_FlagName[3:4]: FlagD,
+}
+
+// ParseFlag attempts to convert a string to a Flag.
+func ParseFlag(name string) (Flag, error) {
+	if x, ok := _FlagValue[name]; ok {
+		return x, nil
+	}
+	return Flag(0), fmt.Errorf("%s is %w", name, ErrInvalidFlag)
+}
+
+const (
+	// RegisterStatus is a Register of type Status.
+	RegisterStatus Register = iota + 16
+	// RegisterControl is a Register of type Control.
+	RegisterControl Register = iota + 19
+	// RegisterData is a Register of type Data.
+	RegisterData Register = iota + 26
+)
+
+var ErrInvalidRegister = errors.New("not a valid Register")
+
+const _RegisterName = "statuscontroldata"
+
+var _RegisterMap = map[Register]string{
+	RegisterStatus:  _RegisterName[0:6],
+	RegisterControl: _RegisterName[6:13],
+	RegisterData:    _RegisterName[13:17],
+}
+
+// String implements the Stringer interface.
+func (x Register) String() string {
+	if str, ok := _RegisterMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("Register(%d)", x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x Register) IsValid() bool {
+	_, ok := _RegisterMap[x]
+	return ok
+}
+
+var _RegisterValue = map[string]Register{
+	_RegisterName[0:6]:   RegisterStatus,
+	_RegisterName[6:13]:  RegisterControl,
+	_RegisterName[13:17]: RegisterData,
+}
+
+// ParseRegister attempts to convert a string to a Register.
+func ParseRegister(name string) (Register, error) {
+	if x, ok := _RegisterValue[name]; ok {
+		return x, nil
+	}
+	return Register(0), fmt.Errorf("%s is %w", name, ErrInvalidRegister)
+}
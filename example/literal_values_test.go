@@ -0,0 +1,27 @@
+//go:build example
+// +build example
+
+package example
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLiteralValues(t *testing.T) {
+	assert.Equal(t, Flag(1), FlagA)
+	assert.Equal(t, Flag(2), FlagB)
+	assert.Equal(t, Flag(4), FlagC)
+	assert.Equal(t, Flag(8), FlagD)
+	assert.Equal(t, "c", FlagC.String())
+
+	assert.Equal(t, Register(16), RegisterStatus)
+	assert.Equal(t, Register(20), RegisterControl)
+	assert.Equal(t, Register(28), RegisterData)
+
+	x, err := ParseRegister("data")
+	assert.NoError(t, err)
+	assert.Equal(t, RegisterData, x)
+	assert.False(t, Register(0x11).IsValid())
+}
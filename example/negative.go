@@ -20,3 +20,8 @@ Ugly
 ).
 */
 type AllNegative int
+
+// State mixes a negative, a zero and a positive value.
+// @marshal @sqlint
+// ENUM(unknown=-1, ok=0, warn=1)
+type State int
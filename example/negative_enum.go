@@ -10,8 +10,12 @@
 package example
 
 import (
+	"database/sql"
+	"database/sql/driver"
 	"errors"
 	"fmt"
+	"math"
+	"strconv"
 	"strings"
 )
 
@@ -53,13 +57,16 @@ func (x AllNegative) IsValid() bool {
 }
 
 var _AllNegativeValue = map[string]AllNegative{
-	_AllNegativeName[0:7]:                    AllNegativeUnknown,
+	_AllNegativeName[0:7]:   AllNegativeUnknown,
+	_AllNegativeName[7:11]:  AllNegativeGood,
+	_AllNegativeName[11:14]: AllNegativeBad,
+	_AllNegativeName[14:18]: AllNegativeUgly,
+}
+
+var _AllNegativeLowerValue = map[string]AllNegative{
 	strings.ToLower(_AllNegativeName[0:7]):   AllNegativeUnknown,
-	_AllNegativeName[7:11]:                   AllNegativeGood,
 	strings.ToLower(_AllNegativeName[7:11]):  AllNegativeGood,
-	_AllNegativeName[11:14]:                  AllNegativeBad,
 	strings.ToLower(_AllNegativeName[11:14]): AllNegativeBad,
-	_AllNegativeName[14:18]:                  AllNegativeUgly,
 	strings.ToLower(_AllNegativeName[14:18]): AllNegativeUgly,
 }
 
@@ -69,12 +76,243 @@ func ParseAllNegative(name string) (AllNegative, error) {
 		return x, nil
 	}
 	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of lowercasing a string if we don't need to.
-	if x, ok := _AllNegativeValue[strings.ToLower(name)]; ok {
-		return x, nil
+	// Inputs too long to lowercase into a name are not normalized at all.
+	if len(name) <= 28 {
+		if x, ok := _AllNegativeLowerValue[strings.ToLower(name)]; ok {
+			return x, nil
+		}
 	}
 	return AllNegative(0), fmt.Errorf("%s is %w", name, ErrInvalidAllNegative)
 }
 
+const (
+	// StateUnknown is a State of type Unknown.
+	StateUnknown State = iota + -1
+	// StateOk is a State of type Ok.
+	StateOk
+	// StateWarn is a State of type Warn.
+	StateWarn
+)
+
+var ErrInvalidState = errors.New("not a valid State")
+
+const _StateName = "unknownokwarn"
+
+var _StateMap = map[State]string{
+	StateUnknown: _StateName[0:7],
+	StateOk:      _StateName[7:9],
+	StateWarn:    _StateName[9:13],
+}
+
+// String implements the Stringer interface.
+func (x State) String() string {
+	if str, ok := _StateMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("State(%d)", x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x State) IsValid() bool {
+	_, ok := _StateMap[x]
+	return ok
+}
+
+var _StateValue = map[string]State{
+	_StateName[0:7]:  StateUnknown,
+	_StateName[7:9]:  StateOk,
+	_StateName[9:13]: StateWarn,
+}
+
+var _StateLowerValue = map[string]State{
+	strings.ToLower(_StateName[0:7]):  StateUnknown,
+	strings.ToLower(_StateName[7:9]):  StateOk,
+	strings.ToLower(_StateName[9:13]): StateWarn,
+}
+
+// ParseState attempts to convert a string to a State.
+func ParseState(name string) (State, error) {
+	if x, ok := _StateValue[name]; ok {
+		return x, nil
+	}
+	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of lowercasing a string if we don't need to.
+	// Inputs too long to lowercase into a name are not normalized at all.
+	if len(name) <= 28 {
+		if x, ok := _StateLowerValue[strings.ToLower(name)]; ok {
+			return x, nil
+		}
+	}
+	return State(0), fmt.Errorf("%s is %w", name, ErrInvalidState)
+}
+
+// MarshalText implements the text marshaller method.
+func (x State) MarshalText() ([]byte, error) {
+	return x.AppendText(nil)
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *State) UnmarshalText(text []byte) error {
+	name := string(text)
+	tmp, err := ParseState(name)
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// AppendText appends the textual representation of itself to the end of b
+// (allocating a larger slice if necessary) and returns the updated slice.
+//
+// Implementations must not retain b, nor mutate any bytes within b[:len(b)].
+func (x State) AppendText(b []byte) ([]byte, error) {
+	return append(b, x.String()...), nil
+}
+
+var errStateNilPtr = errors.New("value pointer is nil") // one per type for package clashes
+
+// Scan implements the Scanner interface.
+func (x *State) Scan(value interface{}) (err error) {
+	if value == nil {
+		*x = State(0)
+		return
+	}
+
+	// sql.RawBytes is a distinct type, treat it like any other []byte.
+	if raw, ok := value.(sql.RawBytes); ok {
+		value = []byte(raw)
+	}
+
+	// A wider range of scannable types.
+	// driver.Value values at the top of the list for expediency
+	switch v := value.(type) {
+	case int64:
+		*x, err = _StateFromInt64(v)
+	case string:
+		*x, err = ParseState(v)
+		if err != nil {
+			// try parsing the integer value as a string
+			if val, verr := strconv.Atoi(v); verr == nil {
+				*x, err = _StateFromInt64(int64(val))
+			}
+		}
+	case []byte:
+		*x, err = ParseState(string(v))
+		if err != nil {
+			// try parsing the integer value as a string
+			if val, verr := strconv.Atoi(string(v)); verr == nil {
+				*x, err = _StateFromInt64(int64(val))
+			}
+		}
+	case State:
+		// An already typed value is assigned as is, once it is known to be declared
+		if !v.IsValid() {
+			return fmt.Errorf("%d is %w", v, ErrInvalidState)
+		}
+		*x = v
+	case *State:
+		if v == nil {
+			return errStateNilPtr
+		}
+		if !v.IsValid() {
+			return fmt.Errorf("%d is %w", *v, ErrInvalidState)
+		}
+		*x = *v
+	case int:
+		*x, err = _StateFromInt64(int64(v))
+	case int8:
+		*x, err = _StateFromInt64(int64(v))
+	case int16:
+		*x, err = _StateFromInt64(int64(v))
+	case int32:
+		*x, err = _StateFromInt64(int64(v))
+	case uint:
+		*x, err = _StateFromUint64(uint64(v))
+	case uint8:
+		*x, err = _StateFromUint64(uint64(v))
+	case uint16:
+		*x, err = _StateFromUint64(uint64(v))
+	case uint32:
+		*x, err = _StateFromUint64(uint64(v))
+	case uint64:
+		*x, err = _StateFromUint64(v)
+	case *int:
+		if v == nil {
+			return errStateNilPtr
+		}
+		*x, err = _StateFromInt64(int64(*v))
+	case *int64:
+		if v == nil {
+			return errStateNilPtr
+		}
+		*x, err = _StateFromInt64(*v)
+	case float64: // json marshals everything as a float64 if it's a number
+		*x, err = _StateFromFloat64(v)
+	case *float64: // json marshals everything as a float64 if it's a number
+		if v == nil {
+			return errStateNilPtr
+		}
+		*x, err = _StateFromFloat64(*v)
+	case *uint:
+		if v == nil {
+			return errStateNilPtr
+		}
+		*x, err = _StateFromUint64(uint64(*v))
+	case *uint64:
+		if v == nil {
+			return errStateNilPtr
+		}
+		*x, err = _StateFromUint64(*v)
+	case *string:
+		if v == nil {
+			return errStateNilPtr
+		}
+		*x, err = ParseState(*v)
+		if err != nil {
+			// try parsing the integer value as a string
+			if val, verr := strconv.Atoi(*v); verr == nil {
+				*x, err = _StateFromInt64(int64(val))
+			}
+		}
+	default:
+		return fmt.Errorf("invalid type %T for State", value)
+	}
+
+	return
+}
+
+// _StateFromInt64 converts an integer read from the database, failing when it does not fit in State
+// or is not one of its values.
+func _StateFromInt64(v int64) (State, error) {
+	x := State(v)
+	if int64(x) != v || !x.IsValid() {
+		return State(0), fmt.Errorf("%d is %w", v, ErrInvalidState)
+	}
+	return x, nil
+}
+
+// _StateFromUint64 converts an unsigned integer read from the database, like _StateFromInt64.
+func _StateFromUint64(v uint64) (State, error) {
+	if v > math.MaxInt64 {
+		return State(0), fmt.Errorf("%d is %w", v, ErrInvalidState)
+	}
+	return _StateFromInt64(int64(v))
+}
+
+// _StateFromFloat64 converts a number decoded as a float64, like _StateFromInt64, failing when it is not a whole number.
+func _StateFromFloat64(v float64) (State, error) {
+	if v != math.Trunc(v) || v < math.MinInt64 || v >= math.MaxInt64 {
+		return State(0), fmt.Errorf("%v is %w", v, ErrInvalidState)
+	}
+	return _StateFromInt64(int64(v))
+}
+
+// Value implements the driver Valuer interface.
+func (x State) Value() (driver.Value, error) {
+	return int64(x), nil
+}
+
 const (
 	// StatusUnknown is a Status of type Unknown.
 	StatusUnknown Status = iota + -1
@@ -110,11 +348,14 @@ func (x Status) IsValid() bool {
 }
 
 var _StatusValue = map[string]Status{
-	_StatusName[0:7]:                    StatusUnknown,
+	_StatusName[0:7]:   StatusUnknown,
+	_StatusName[7:11]:  StatusGood,
+	_StatusName[11:14]: StatusBad,
+}
+
+var _StatusLowerValue = map[string]Status{
 	strings.ToLower(_StatusName[0:7]):   StatusUnknown,
-	_StatusName[7:11]:                   StatusGood,
 	strings.ToLower(_StatusName[7:11]):  StatusGood,
-	_StatusName[11:14]:                  StatusBad,
 	strings.ToLower(_StatusName[11:14]): StatusBad,
 }
 
@@ -124,8 +365,11 @@ func ParseStatus(name string) (Status, error) {
 		return x, nil
 	}
 	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of lowercasing a string if we don't need to.
-	if x, ok := _StatusValue[strings.ToLower(name)]; ok {
-		return x, nil
+	// Inputs too long to lowercase into a name are not normalized at all.
+	if len(name) <= 28 {
+		if x, ok := _StatusLowerValue[strings.ToLower(name)]; ok {
+			return x, nil
+		}
 	}
 	return Status(0), fmt.Errorf("%s is %w", name, ErrInvalidStatus)
 }
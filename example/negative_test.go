@@ -4,9 +4,11 @@
 package example
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestStatusString(t *testing.T) {
@@ -98,3 +100,39 @@ func TestNegativeString(t *testing.T) {
 		assert.Equal(t, AllNegativeUgly, actual)
 	})
 }
+
+func TestNegativeAndZeroValues(t *testing.T) {
+	assert.Equal(t, State(-1), StateUnknown)
+	assert.Equal(t, State(0), StateOk)
+	assert.Equal(t, State(1), StateWarn)
+
+	for _, state := range []State{StateUnknown, StateOk, StateWarn} {
+		assert.True(t, state.IsValid())
+
+		parsed, err := ParseState(state.String())
+		require.NoError(t, err)
+		assert.Equal(t, state, parsed)
+
+		b, err := json.Marshal(state)
+		require.NoError(t, err)
+		var unmarshaled State
+		require.NoError(t, json.Unmarshal(b, &unmarshaled))
+		assert.Equal(t, state, unmarshaled)
+
+		value, err := state.Value()
+		require.NoError(t, err)
+		assert.Equal(t, int64(state), value)
+		var scanned State
+		require.NoError(t, scanned.Scan(value))
+		assert.Equal(t, state, scanned)
+	}
+
+	assert.Equal(t, "unknown", StateUnknown.String())
+	assert.False(t, State(-2).IsValid())
+	assert.Equal(t, "State(-2)", State(-2).String())
+
+	var scanned State
+	require.NoError(t, scanned.Scan("-1"))
+	assert.Equal(t, StateUnknown, scanned)
+	assert.ErrorIs(t, scanned.Scan(int64(-2)), ErrInvalidState)
+}
@@ -10,9 +10,11 @@
 package example
 
 import (
+	"database/sql"
 	"database/sql/driver"
 	"errors"
 	"fmt"
+	"math"
 )
 
 const (
@@ -67,6 +69,11 @@ func (x *UnparsedSqlString) Scan(value interface{}) (err error) {
 		return
 	}
 
+	// sql.RawBytes is a distinct type, treat it like any other []byte.
+	if raw, ok := value.(sql.RawBytes); ok {
+		value = []byte(raw)
+	}
+
 	// A wider range of scannable types.
 	// driver.Value values at the top of the list for expediency
 	switch v := value.(type) {
@@ -75,11 +82,18 @@ func (x *UnparsedSqlString) Scan(value interface{}) (err error) {
 	case []byte:
 		*x, err = parseUnparsedSqlString(string(v))
 	case UnparsedSqlString:
+		// An already typed value is assigned as is, once it is known to be declared
+		if !v.IsValid() {
+			return fmt.Errorf("%s is %w", string(v), ErrInvalidUnparsedSqlString)
+		}
 		*x = v
 	case *UnparsedSqlString:
 		if v == nil {
 			return errUnparsedSqlStringNilPtr
 		}
+		if !v.IsValid() {
+			return fmt.Errorf("%s is %w", string(*v), ErrInvalidUnparsedSqlString)
+		}
 		*x = *v
 	case *string:
 		if v == nil {
@@ -87,7 +101,7 @@ func (x *UnparsedSqlString) Scan(value interface{}) (err error) {
 		}
 		*x, err = parseUnparsedSqlString(*v)
 	default:
-		return errors.New("invalid type for UnparsedSqlString")
+		return fmt.Errorf("invalid type %T for UnparsedSqlString", value)
 	}
 
 	return
@@ -163,65 +177,117 @@ func (x *UnparsedSqlValues) Scan(value interface{}) (err error) {
 		return
 	}
 
+	// sql.RawBytes is a distinct type, treat it like any other []byte.
+	if raw, ok := value.(sql.RawBytes); ok {
+		value = []byte(raw)
+	}
+
 	// A wider range of scannable types.
 	// driver.Value values at the top of the list for expediency
 	switch v := value.(type) {
 	case int64:
-		*x = UnparsedSqlValues(v)
+		*x, err = _UnparsedSqlValuesFromInt64(v)
 	case string:
 		*x, err = parseUnparsedSqlValues(v)
 	case []byte:
 		*x, err = parseUnparsedSqlValues(string(v))
 	case UnparsedSqlValues:
+		// An already typed value is assigned as is, once it is known to be declared
+		if !v.IsValid() {
+			return fmt.Errorf("%d is %w", v, ErrInvalidUnparsedSqlValues)
+		}
 		*x = v
-	case int:
-		*x = UnparsedSqlValues(v)
 	case *UnparsedSqlValues:
 		if v == nil {
 			return errUnparsedSqlValuesNilPtr
 		}
+		if !v.IsValid() {
+			return fmt.Errorf("%d is %w", *v, ErrInvalidUnparsedSqlValues)
+		}
 		*x = *v
+	case int:
+		*x, err = _UnparsedSqlValuesFromInt64(int64(v))
+	case int8:
+		*x, err = _UnparsedSqlValuesFromInt64(int64(v))
+	case int16:
+		*x, err = _UnparsedSqlValuesFromInt64(int64(v))
+	case int32:
+		*x, err = _UnparsedSqlValuesFromInt64(int64(v))
 	case uint:
-		*x = UnparsedSqlValues(v)
+		*x, err = _UnparsedSqlValuesFromUint64(uint64(v))
+	case uint8:
+		*x, err = _UnparsedSqlValuesFromUint64(uint64(v))
+	case uint16:
+		*x, err = _UnparsedSqlValuesFromUint64(uint64(v))
+	case uint32:
+		*x, err = _UnparsedSqlValuesFromUint64(uint64(v))
 	case uint64:
-		*x = UnparsedSqlValues(v)
+		*x, err = _UnparsedSqlValuesFromUint64(v)
 	case *int:
 		if v == nil {
 			return errUnparsedSqlValuesNilPtr
 		}
-		*x = UnparsedSqlValues(*v)
+		*x, err = _UnparsedSqlValuesFromInt64(int64(*v))
 	case *int64:
 		if v == nil {
 			return errUnparsedSqlValuesNilPtr
 		}
-		*x = UnparsedSqlValues(*v)
+		*x, err = _UnparsedSqlValuesFromInt64(*v)
 	case float64: // json marshals everything as a float64 if it's a number
-		*x = UnparsedSqlValues(v)
+		*x, err = _UnparsedSqlValuesFromFloat64(v)
 	case *float64: // json marshals everything as a float64 if it's a number
 		if v == nil {
 			return errUnparsedSqlValuesNilPtr
 		}
-		*x = UnparsedSqlValues(*v)
+		*x, err = _UnparsedSqlValuesFromFloat64(*v)
 	case *uint:
 		if v == nil {
 			return errUnparsedSqlValuesNilPtr
 		}
-		*x = UnparsedSqlValues(*v)
+		*x, err = _UnparsedSqlValuesFromUint64(uint64(*v))
 	case *uint64:
 		if v == nil {
 			return errUnparsedSqlValuesNilPtr
 		}
-		*x = UnparsedSqlValues(*v)
+		*x, err = _UnparsedSqlValuesFromUint64(*v)
 	case *string:
 		if v == nil {
 			return errUnparsedSqlValuesNilPtr
 		}
 		*x, err = parseUnparsedSqlValues(*v)
+	default:
+		return fmt.Errorf("invalid type %T for UnparsedSqlValues", value)
 	}
 
 	return
 }
 
+// _UnparsedSqlValuesFromInt64 converts an integer read from the database, failing when it does not fit in UnparsedSqlValues
+// or is not one of its values.
+func _UnparsedSqlValuesFromInt64(v int64) (UnparsedSqlValues, error) {
+	x := UnparsedSqlValues(v)
+	if int64(x) != v || !x.IsValid() {
+		return UnparsedSqlValues(0), fmt.Errorf("%d is %w", v, ErrInvalidUnparsedSqlValues)
+	}
+	return x, nil
+}
+
+// _UnparsedSqlValuesFromUint64 converts an unsigned integer read from the database, like _UnparsedSqlValuesFromInt64.
+func _UnparsedSqlValuesFromUint64(v uint64) (UnparsedSqlValues, error) {
+	if v > math.MaxInt64 {
+		return UnparsedSqlValues(0), fmt.Errorf("%d is %w", v, ErrInvalidUnparsedSqlValues)
+	}
+	return _UnparsedSqlValuesFromInt64(int64(v))
+}
+
+// _UnparsedSqlValuesFromFloat64 converts a number decoded as a float64, like _UnparsedSqlValuesFromInt64, failing when it is not a whole number.
+func _UnparsedSqlValuesFromFloat64(v float64) (UnparsedSqlValues, error) {
+	if v != math.Trunc(v) || v < math.MinInt64 || v >= math.MaxInt64 {
+		return UnparsedSqlValues(0), fmt.Errorf("%v is %w", v, ErrInvalidUnparsedSqlValues)
+	}
+	return _UnparsedSqlValuesFromInt64(int64(v))
+}
+
 // Value implements the driver Valuer interface.
 func (x UnparsedSqlValues) Value() (driver.Value, error) {
 	return x.String(), nil
@@ -0,0 +1,8 @@
+//go:generate ../bin/go-enum --marshal --flag -b example
+
+package example
+
+// Permission is a set of access rights that can be combined.
+// @bitflag @ordefault @predicates @flagset
+// ENUM(read, write, execute)
+type Permission int
@@ -0,0 +1,253 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: example
+// Revision: example
+// Build Date: example
+// Built By: example
+
+//go:build example
+// +build example
+
+package example
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const (
+	// PermissionRead is a Permission of type Read.
+	PermissionRead Permission = 1 << iota
+	// PermissionWrite is a Permission of type Write.
+	PermissionWrite
+	// PermissionExecute is a Permission of type Execute.
+	PermissionExecute
+)
+
+var ErrInvalidPermission = errors.New("not a valid Permission")
+
+const _PermissionName = "readwriteexecute"
+
+var _PermissionMap = map[Permission]string{
+	PermissionRead:    _PermissionName[0:4],
+	PermissionWrite:   _PermissionName[4:9],
+	PermissionExecute: _PermissionName[9:16],
+}
+
+// _PermissionFlags holds the declared flags in declaration order.
+var _PermissionFlags = []Permission{
+	PermissionRead,
+	PermissionWrite,
+	PermissionExecute,
+}
+
+// _PermissionMask is the combination of all the declared flags.
+const _PermissionMask = PermissionRead | PermissionWrite | PermissionExecute
+
+// String implements the Stringer interface.
+// Combined flags are joined with a `|`.
+func (x Permission) String() string {
+	if str, ok := _PermissionMap[x]; ok {
+		return str
+	}
+	var names []string
+	remaining := x
+	for _, flag := range _PermissionFlags {
+		if flag != 0 && x&flag == flag {
+			names = append(names, _PermissionMap[flag])
+			remaining &^= flag
+		}
+	}
+	if remaining != 0 || len(names) == 0 {
+		return fmt.Sprintf("Permission(%d)", x)
+	}
+	return strings.Join(names, "|")
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// a combination of the allowed enumerated values
+func (x Permission) IsValid() bool {
+	if _, ok := _PermissionMap[x]; ok {
+		return true
+	}
+	return x != 0 && x&^_PermissionMask == 0
+}
+
+// Has returns true if all the flags of other are set in x.
+func (x Permission) Has(other Permission) bool {
+	return x&other == other
+}
+
+// Add returns x with the flags of other set.
+func (x Permission) Add(other Permission) Permission {
+	return x | other
+}
+
+// Remove returns x with the flags of other cleared.
+func (x Permission) Remove(other Permission) Permission {
+	return x &^ other
+}
+
+// PermissionSet is a combination of Permission flags.
+type PermissionSet Permission
+
+// NewPermissionSet returns a PermissionSet holding the given flags.
+func NewPermissionSet(flags ...Permission) PermissionSet {
+	var s PermissionSet
+	s.Add(flags...)
+	return s
+}
+
+// Add sets the given flags in s.
+func (s *PermissionSet) Add(flags ...Permission) {
+	for _, flag := range flags {
+		*s |= PermissionSet(flag)
+	}
+}
+
+// Remove clears the given flags from s.
+func (s *PermissionSet) Remove(flags ...Permission) {
+	for _, flag := range flags {
+		*s &^= PermissionSet(flag)
+	}
+}
+
+// Contains returns true if all the bits of flag are set in s.
+func (s PermissionSet) Contains(flag Permission) bool {
+	return Permission(s).Has(flag)
+}
+
+// Flags returns the combination of the flags of s as a Permission.
+func (s PermissionSet) Flags() Permission {
+	return Permission(s)
+}
+
+// ToSlice returns the declared flags set in s, in declaration order.
+func (s PermissionSet) ToSlice() []Permission {
+	var flags []Permission
+	for _, flag := range _PermissionFlags {
+		if flag != 0 && s.Contains(flag) {
+			flags = append(flags, flag)
+		}
+	}
+	return flags
+}
+
+// String implements the Stringer interface, joining the names of the flags of s with a `|`.
+func (s PermissionSet) String() string {
+	return Permission(s).String()
+}
+
+var _PermissionValue = map[string]Permission{
+	_PermissionName[0:4]:  PermissionRead,
+	_PermissionName[4:9]:  PermissionWrite,
+	_PermissionName[9:16]: PermissionExecute,
+}
+
+// ParsePermission attempts to convert a string to a Permission.
+func ParsePermission(name string) (Permission, error) {
+	if x, ok := _PermissionValue[name]; ok {
+		return x, nil
+	}
+	// Combined flags are separated with a `|`.
+	if strings.Contains(name, "|") {
+		var x Permission
+		for _, part := range strings.Split(name, "|") {
+			flag, err := ParsePermission(strings.TrimSpace(part))
+			if err != nil {
+				return Permission(0), err
+			}
+			x |= flag
+		}
+		return x, nil
+	}
+	return Permission(0), fmt.Errorf("%s is %w", name, ErrInvalidPermission)
+}
+
+// lookupPermission converts a string to a Permission, reporting whether it is valid instead of building an error.
+func lookupPermission(name string) (Permission, bool) {
+	if x, ok := _PermissionValue[name]; ok {
+		return x, true
+	}
+	// Combined flags are separated with a `|`.
+	if strings.Contains(name, "|") {
+		var x Permission
+		for _, part := range strings.Split(name, "|") {
+			flag, ok := lookupPermission(strings.TrimSpace(part))
+			if !ok {
+				return Permission(0), false
+			}
+			x |= flag
+		}
+		return x, true
+	}
+	return Permission(0), false
+}
+
+// ParsePermissionOrDefault converts a string to a Permission, returning def when it is not valid.
+func ParsePermissionOrDefault(name string, def Permission) Permission {
+	if x, ok := lookupPermission(name); ok {
+		return x
+	}
+	return def
+}
+
+// MarshalText implements the text marshaller method.
+func (x Permission) MarshalText() ([]byte, error) {
+	return x.AppendText(nil)
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *Permission) UnmarshalText(text []byte) error {
+	name := string(text)
+	tmp, err := ParsePermission(name)
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// AppendText appends the textual representation of itself to the end of b
+// (allocating a larger slice if necessary) and returns the updated slice.
+//
+// Implementations must not retain b, nor mutate any bytes within b[:len(b)].
+func (x Permission) AppendText(b []byte) ([]byte, error) {
+	return append(b, x.String()...), nil
+}
+
+// IsRead reports whether the PermissionRead flag is set in x.
+func (x Permission) IsRead() bool {
+	return x.Has(PermissionRead)
+}
+
+// IsWrite reports whether the PermissionWrite flag is set in x.
+func (x Permission) IsWrite() bool {
+	return x.Has(PermissionWrite)
+}
+
+// IsExecute reports whether the PermissionExecute flag is set in x.
+func (x Permission) IsExecute() bool {
+	return x.Has(PermissionExecute)
+}
+
+// Set implements the Golang flag.Value interface func.
+// Repeating the flag adds the flags of every occurrence.
+func (x *Permission) Set(val string) error {
+	v, err := ParsePermission(val)
+	if err != nil {
+		return err
+	}
+	*x |= v
+	return nil
+}
+
+// Get implements the Golang flag.Getter interface func.
+func (x *Permission) Get() interface{} {
+	return *x
+}
+
+// Type implements the github.com/spf13/pFlag Value interface.
+func (x *Permission) Type() string {
+	return "Permission"
+}
@@ -0,0 +1,124 @@
+//go:build example
+// +build example
+
+package example
+
+import (
+	"encoding/json"
+	"flag"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPermissionValues(t *testing.T) {
+	assert.Equal(t, Permission(1), PermissionRead)
+	assert.Equal(t, Permission(2), PermissionWrite)
+	assert.Equal(t, Permission(4), PermissionExecute)
+}
+
+func TestPermissionCombined(t *testing.T) {
+	perm := PermissionRead.Add(PermissionWrite)
+	assert.Equal(t, Permission(3), perm)
+	assert.True(t, perm.Has(PermissionRead))
+	assert.True(t, perm.Has(PermissionWrite))
+	assert.True(t, perm.Has(PermissionRead|PermissionWrite))
+	assert.False(t, perm.Has(PermissionExecute))
+	assert.True(t, perm.IsValid())
+	assert.Equal(t, "read|write", perm.String())
+
+	perm = perm.Remove(PermissionRead)
+	assert.Equal(t, PermissionWrite, perm)
+	assert.Equal(t, "write", perm.String())
+
+	// Undeclared bits are not valid
+	assert.False(t, Permission(8).IsValid())
+	assert.False(t, Permission(9).IsValid())
+	assert.Equal(t, "Permission(9)", Permission(9).String())
+	assert.False(t, Permission(0).IsValid())
+}
+
+func TestPermissionParse(t *testing.T) {
+	perm, err := ParsePermission("read|write")
+	require.NoError(t, err)
+	assert.Equal(t, PermissionRead|PermissionWrite, perm)
+
+	perm, err = ParsePermission("execute | read")
+	require.NoError(t, err)
+	assert.Equal(t, PermissionRead|PermissionExecute, perm)
+	assert.Equal(t, "read|execute", perm.String())
+
+	_, err = ParsePermission("read|delete")
+	assert.EqualError(t, err, "delete is not a valid Permission")
+}
+
+func TestPermissionParseOrDefault(t *testing.T) {
+	assert.Equal(t, PermissionRead|PermissionWrite, ParsePermissionOrDefault("read | write", PermissionRead))
+	assert.Equal(t, PermissionRead, ParsePermissionOrDefault("read|delete", PermissionRead))
+	assert.Equal(t, PermissionExecute, ParsePermissionOrDefault("bogus", PermissionExecute))
+}
+
+func TestPermissionMarshal(t *testing.T) {
+	data := struct {
+		Perm Permission `json:"perm"`
+	}{Perm: PermissionRead | PermissionWrite}
+
+	raw, err := json.Marshal(data)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"perm":"read|write"}`, string(raw))
+
+	data.Perm = 0
+	require.NoError(t, json.Unmarshal(raw, &data))
+	assert.Equal(t, PermissionRead|PermissionWrite, data.Perm)
+}
+
+func TestPermissionFlagRepeated(t *testing.T) {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	var perm Permission
+	fs.Var(&perm, "perm", "permissions to grant, can be repeated")
+
+	require.NoError(t, fs.Parse([]string{"-perm=read", "-perm", "execute"}))
+	assert.Equal(t, PermissionRead|PermissionExecute, perm)
+
+	// Combined values work in a single occurrence too
+	perm = 0
+	require.NoError(t, fs.Parse([]string{"-perm=read|write"}))
+	assert.Equal(t, PermissionRead|PermissionWrite, perm)
+
+	// A bad value leaves the flags already set alone
+	assert.Error(t, fs.Parse([]string{"-perm=delete"}))
+	assert.Equal(t, PermissionRead|PermissionWrite, perm)
+}
+
+func TestPermissionPredicates(t *testing.T) {
+	// Bitflag predicates check a single flag of a combined value
+	rw := PermissionRead | PermissionWrite
+	assert.True(t, rw.IsRead())
+	assert.True(t, rw.IsWrite())
+	assert.False(t, rw.IsExecute())
+}
+
+func TestPermissionSet(t *testing.T) {
+	var s PermissionSet
+	assert.Empty(t, s.ToSlice())
+
+	s.Add(PermissionExecute, PermissionRead)
+	assert.True(t, s.Contains(PermissionRead))
+	assert.True(t, s.Contains(PermissionExecute))
+	assert.False(t, s.Contains(PermissionWrite))
+	assert.False(t, s.Contains(PermissionRead|PermissionWrite))
+	assert.Equal(t, []Permission{PermissionRead, PermissionExecute}, s.ToSlice())
+	assert.Equal(t, "read|execute", s.String())
+	assert.Equal(t, PermissionRead|PermissionExecute, s.Flags())
+
+	s.Remove(PermissionRead)
+	assert.False(t, s.Contains(PermissionRead))
+	assert.Equal(t, []Permission{PermissionExecute}, s.ToSlice())
+
+	s.Add(PermissionWrite | PermissionRead)
+	assert.Equal(t, []Permission{PermissionRead, PermissionWrite, PermissionExecute}, s.ToSlice())
+	assert.Equal(t, NewPermissionSet(PermissionRead, PermissionWrite, PermissionExecute), s)
+}
@@ -0,0 +1,7 @@
+//go:generate ../bin/go-enum --proto --noparse -b example
+
+package example
+
+// ProtoPriority mirrors a protobuf enum, so its numbers must stay stable on the wire.
+// ENUM(Unspecified, Low=10, Medium=20, High=30)
+type ProtoPriority int32
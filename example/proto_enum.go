@@ -0,0 +1,73 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: example
+// Revision: example
+// Build Date: example
+// Built By: example
+
+//go:build example
+// +build example
+
+package example
+
+import (
+	"errors"
+	"fmt"
+)
+
+const (
+	// ProtoPriorityUnspecified is a ProtoPriority of type Unspecified.
+	ProtoPriorityUnspecified ProtoPriority = iota
+	// ProtoPriorityLow is a ProtoPriority of type Low.
+	ProtoPriorityLow ProtoPriority = iota + 9
+	// ProtoPriorityMedium is a ProtoPriority of type Medium.
+	ProtoPriorityMedium ProtoPriority = iota + 18
+	// ProtoPriorityHigh is a ProtoPriority of type High.
+	ProtoPriorityHigh ProtoPriority = iota + 27
+)
+
+var ErrInvalidProtoPriority = errors.New("not a valid ProtoPriority")
+
+const _ProtoPriorityName = "UnspecifiedLowMediumHigh"
+
+var _ProtoPriorityMap = map[ProtoPriority]string{
+	ProtoPriorityUnspecified: _ProtoPriorityName[0:11],
+	ProtoPriorityLow:         _ProtoPriorityName[11:14],
+	ProtoPriorityMedium:      _ProtoPriorityName[14:20],
+	ProtoPriorityHigh:        _ProtoPriorityName[20:24],
+}
+
+// String implements the Stringer interface.
+func (x ProtoPriority) String() string {
+	if str, ok := _ProtoPriorityMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("ProtoPriority(%d)", x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x ProtoPriority) IsValid() bool {
+	_, ok := _ProtoPriorityMap[x]
+	return ok
+}
+
+var _ProtoPriorityValue = map[string]ProtoPriority{
+	_ProtoPriorityName[0:11]:  ProtoPriorityUnspecified,
+	_ProtoPriorityName[11:14]: ProtoPriorityLow,
+	_ProtoPriorityName[14:20]: ProtoPriorityMedium,
+	_ProtoPriorityName[20:24]: ProtoPriorityHigh,
+}
+
+// ToProto converts the ProtoPriority to its protobuf int32 number.
+func (x ProtoPriority) ToProto() int32 {
+	return int32(x)
+}
+
+// ProtoPriorityFromProto converts a protobuf int32 number to a ProtoPriority.
+func ProtoPriorityFromProto(v int32) (ProtoPriority, error) {
+	x := ProtoPriority(v)
+	if int32(x) != v || !x.IsValid() {
+		return ProtoPriority(0), fmt.Errorf("%d is %w", v, ErrInvalidProtoPriority)
+	}
+	return x, nil
+}
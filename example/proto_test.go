@@ -0,0 +1,29 @@
+//go:build example
+// +build example
+
+package example
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProtoPriorityRoundTrip(t *testing.T) {
+	for _, x := range []ProtoPriority{ProtoPriorityUnspecified, ProtoPriorityLow, ProtoPriorityMedium, ProtoPriorityHigh} {
+		t.Run(x.String(), func(t *testing.T) {
+			back, err := ProtoPriorityFromProto(x.ToProto())
+			require.NoError(t, err)
+			assert.Equal(t, x, back)
+		})
+	}
+	assert.Equal(t, int32(20), ProtoPriorityMedium.ToProto())
+}
+
+func TestProtoPriorityFromProtoUnknown(t *testing.T) {
+	x, err := ProtoPriorityFromProto(15)
+	assert.ErrorIs(t, err, ErrInvalidProtoPriority)
+	assert.EqualError(t, err, "15 is not a valid ProtoPriority")
+	assert.Equal(t, ProtoPriority(0), x)
+}
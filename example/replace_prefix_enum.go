@@ -66,7 +66,7 @@ func ParseShop(name string) (Shop, error) {
 
 // MarshalText implements the text marshaller method.
 func (x Shop) MarshalText() ([]byte, error) {
-	return []byte(string(x)), nil
+	return x.AppendText(nil)
 }
 
 // UnmarshalText implements the text unmarshaller method.
@@ -83,6 +83,6 @@ func (x *Shop) UnmarshalText(text []byte) error {
 // (allocating a larger slice if necessary) and returns the updated slice.
 //
 // Implementations must not retain b, nor mutate any bytes within b[:len(b)].
-func (x *Shop) AppendText(b []byte) ([]byte, error) {
+func (x Shop) AppendText(b []byte) ([]byte, error) {
 	return append(b, x.String()...), nil
 }
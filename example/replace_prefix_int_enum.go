@@ -77,7 +77,7 @@ func ParseIntShop(name string) (IntShop, error) {
 
 // MarshalText implements the text marshaller method.
 func (x IntShop) MarshalText() ([]byte, error) {
-	return []byte(x.String()), nil
+	return x.AppendText(nil)
 }
 
 // UnmarshalText implements the text unmarshaller method.
@@ -95,6 +95,6 @@ func (x *IntShop) UnmarshalText(text []byte) error {
 // (allocating a larger slice if necessary) and returns the updated slice.
 //
 // Implementations must not retain b, nor mutate any bytes within b[:len(b)].
-func (x *IntShop) AppendText(b []byte) ([]byte, error) {
+func (x IntShop) AppendText(b []byte) ([]byte, error) {
 	return append(b, x.String()...), nil
 }
@@ -0,0 +1,18 @@
+//go:generate ../bin/go-enum -b example
+
+package example
+
+// Flags packs file permissions into a single byte.
+// @bitflag @sqlint @marshalnumeric @binary
+// ENUM(read, write, exec)
+type Flags uint8
+
+// Offset is a small signed adjustment stored in a smallint column.
+// @sql @sqlint @marshalnumeric
+// ENUM(back=-2, still=0, forward=2)
+type Offset int16
+
+// Mask spans the whole range of a uint64, up to its highest bit.
+// @cbor @msgpack
+// ENUM(low=1, high=9223372036854775808)
+type Mask uint64
@@ -0,0 +1,680 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: example
+// Revision: example
+// Build Date: example
+// Built By: example
+
+//go:build example
+// +build example
+
+package example
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/binary"
+	json "encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+)
+
+const (
+	// FlagsRead is a Flags of type Read.
+	FlagsRead Flags = 1 << iota
+	// FlagsWrite is a Flags of type Write.
+	FlagsWrite
+	// FlagsExec is a Flags of type Exec.
+	FlagsExec
+)
+
+var ErrInvalidFlags = errors.New("not a valid Flags")
+
+const _FlagsName = "readwriteexec"
+
+var _FlagsMap = map[Flags]string{
+	FlagsRead:  _FlagsName[0:4],
+	FlagsWrite: _FlagsName[4:9],
+	FlagsExec:  _FlagsName[9:13],
+}
+
+// _FlagsFlags holds the declared flags in declaration order.
+var _FlagsFlags = []Flags{
+	FlagsRead,
+	FlagsWrite,
+	FlagsExec,
+}
+
+// _FlagsMask is the combination of all the declared flags.
+const _FlagsMask = FlagsRead | FlagsWrite | FlagsExec
+
+// String implements the Stringer interface.
+// Combined flags are joined with a `|`.
+func (x Flags) String() string {
+	if str, ok := _FlagsMap[x]; ok {
+		return str
+	}
+	var names []string
+	remaining := x
+	for _, flag := range _FlagsFlags {
+		if flag != 0 && x&flag == flag {
+			names = append(names, _FlagsMap[flag])
+			remaining &^= flag
+		}
+	}
+	if remaining != 0 || len(names) == 0 {
+		return fmt.Sprintf("Flags(%d)", x)
+	}
+	return strings.Join(names, "|")
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// a combination of the allowed enumerated values
+func (x Flags) IsValid() bool {
+	if _, ok := _FlagsMap[x]; ok {
+		return true
+	}
+	return x != 0 && x&^_FlagsMask == 0
+}
+
+// Has returns true if all the flags of other are set in x.
+func (x Flags) Has(other Flags) bool {
+	return x&other == other
+}
+
+// Add returns x with the flags of other set.
+func (x Flags) Add(other Flags) Flags {
+	return x | other
+}
+
+// Remove returns x with the flags of other cleared.
+func (x Flags) Remove(other Flags) Flags {
+	return x &^ other
+}
+
+var _FlagsValue = map[string]Flags{
+	_FlagsName[0:4]:  FlagsRead,
+	_FlagsName[4:9]:  FlagsWrite,
+	_FlagsName[9:13]: FlagsExec,
+}
+
+// ParseFlags attempts to convert a string to a Flags.
+func ParseFlags(name string) (Flags, error) {
+	if x, ok := _FlagsValue[name]; ok {
+		return x, nil
+	}
+	// Combined flags are separated with a `|`.
+	if strings.Contains(name, "|") {
+		var x Flags
+		for _, part := range strings.Split(name, "|") {
+			flag, err := ParseFlags(strings.TrimSpace(part))
+			if err != nil {
+				return Flags(0), err
+			}
+			x |= flag
+		}
+		return x, nil
+	}
+	return Flags(0), fmt.Errorf("%s is %w", name, ErrInvalidFlags)
+}
+
+// MarshalJSON implements the json.Marshaler interface, writing the number of the value.
+func (x Flags) MarshalJSON() ([]byte, error) {
+	return json.Marshal(uint64(x))
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, accepting the number of a value.
+func (x *Flags) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var v uint64
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	tmp := Flags(v)
+	if uint64(tmp) != v || !tmp.IsValid() {
+		return fmt.Errorf("%d is %w", v, ErrInvalidFlags)
+	}
+	*x = tmp
+	return nil
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface, encoding the value as a varint.
+func (x Flags) MarshalBinary() ([]byte, error) {
+	return x.AppendBinary(nil)
+}
+
+// AppendBinary implements the encoding.BinaryAppender interface.
+func (x Flags) AppendBinary(b []byte) ([]byte, error) {
+	return binary.AppendUvarint(b, uint64(x)), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+func (x *Flags) UnmarshalBinary(data []byte) error {
+	v, n := binary.Uvarint(data)
+	if n <= 0 || n != len(data) {
+		return fmt.Errorf("invalid binary data for Flags")
+	}
+	tmp := Flags(v)
+	if uint64(tmp) != v || !tmp.IsValid() {
+		return fmt.Errorf("%d is %w", v, ErrInvalidFlags)
+	}
+	*x = tmp
+	return nil
+}
+
+var errFlagsNilPtr = errors.New("value pointer is nil") // one per type for package clashes
+
+// Scan implements the Scanner interface.
+func (x *Flags) Scan(value interface{}) (err error) {
+	if value == nil {
+		*x = Flags(0)
+		return
+	}
+
+	// sql.RawBytes is a distinct type, treat it like any other []byte.
+	if raw, ok := value.(sql.RawBytes); ok {
+		value = []byte(raw)
+	}
+
+	// A wider range of scannable types.
+	// driver.Value values at the top of the list for expediency
+	switch v := value.(type) {
+	case int64:
+		*x, err = _FlagsFromInt64(v)
+	case string:
+		*x, err = ParseFlags(v)
+		if err != nil {
+			// try parsing the integer value as a string
+			if val, verr := strconv.Atoi(v); verr == nil {
+				*x, err = _FlagsFromInt64(int64(val))
+			}
+		}
+	case []byte:
+		*x, err = ParseFlags(string(v))
+		if err != nil {
+			// try parsing the integer value as a string
+			if val, verr := strconv.Atoi(string(v)); verr == nil {
+				*x, err = _FlagsFromInt64(int64(val))
+			}
+		}
+	case Flags:
+		// An already typed value is assigned as is, once it is known to be declared
+		if !v.IsValid() {
+			return fmt.Errorf("%d is %w", v, ErrInvalidFlags)
+		}
+		*x = v
+	case *Flags:
+		if v == nil {
+			return errFlagsNilPtr
+		}
+		if !v.IsValid() {
+			return fmt.Errorf("%d is %w", *v, ErrInvalidFlags)
+		}
+		*x = *v
+	case int:
+		*x, err = _FlagsFromInt64(int64(v))
+	case int8:
+		*x, err = _FlagsFromInt64(int64(v))
+	case int16:
+		*x, err = _FlagsFromInt64(int64(v))
+	case int32:
+		*x, err = _FlagsFromInt64(int64(v))
+	case uint:
+		*x, err = _FlagsFromUint64(uint64(v))
+	case uint8:
+		*x, err = _FlagsFromUint64(uint64(v))
+	case uint16:
+		*x, err = _FlagsFromUint64(uint64(v))
+	case uint32:
+		*x, err = _FlagsFromUint64(uint64(v))
+	case uint64:
+		*x, err = _FlagsFromUint64(v)
+	case *int:
+		if v == nil {
+			return errFlagsNilPtr
+		}
+		*x, err = _FlagsFromInt64(int64(*v))
+	case *int64:
+		if v == nil {
+			return errFlagsNilPtr
+		}
+		*x, err = _FlagsFromInt64(*v)
+	case float64: // json marshals everything as a float64 if it's a number
+		*x, err = _FlagsFromFloat64(v)
+	case *float64: // json marshals everything as a float64 if it's a number
+		if v == nil {
+			return errFlagsNilPtr
+		}
+		*x, err = _FlagsFromFloat64(*v)
+	case *uint:
+		if v == nil {
+			return errFlagsNilPtr
+		}
+		*x, err = _FlagsFromUint64(uint64(*v))
+	case *uint64:
+		if v == nil {
+			return errFlagsNilPtr
+		}
+		*x, err = _FlagsFromUint64(*v)
+	case *string:
+		if v == nil {
+			return errFlagsNilPtr
+		}
+		*x, err = ParseFlags(*v)
+		if err != nil {
+			// try parsing the integer value as a string
+			if val, verr := strconv.Atoi(*v); verr == nil {
+				*x, err = _FlagsFromInt64(int64(val))
+			}
+		}
+	default:
+		return fmt.Errorf("invalid type %T for Flags", value)
+	}
+
+	return
+}
+
+// _FlagsFromInt64 converts an integer read from the database, failing when it does not fit in Flags
+// or is not one of its values.
+func _FlagsFromInt64(v int64) (Flags, error) {
+	x := Flags(v)
+	if int64(x) != v || !x.IsValid() {
+		return Flags(0), fmt.Errorf("%d is %w", v, ErrInvalidFlags)
+	}
+	return x, nil
+}
+
+// _FlagsFromUint64 converts an unsigned integer read from the database, like _FlagsFromInt64.
+func _FlagsFromUint64(v uint64) (Flags, error) {
+	if v > math.MaxInt64 {
+		return Flags(0), fmt.Errorf("%d is %w", v, ErrInvalidFlags)
+	}
+	return _FlagsFromInt64(int64(v))
+}
+
+// _FlagsFromFloat64 converts a number decoded as a float64, like _FlagsFromInt64, failing when it is not a whole number.
+func _FlagsFromFloat64(v float64) (Flags, error) {
+	if v != math.Trunc(v) || v < math.MinInt64 || v >= math.MaxInt64 {
+		return Flags(0), fmt.Errorf("%v is %w", v, ErrInvalidFlags)
+	}
+	return _FlagsFromInt64(int64(v))
+}
+
+// Value implements the driver Valuer interface.
+func (x Flags) Value() (driver.Value, error) {
+	return int64(x), nil
+}
+
+const (
+	// MaskLow is a Mask of type Low.
+	MaskLow Mask = iota + 1
+	// MaskHigh is a Mask of type High.
+	MaskHigh Mask = iota + 9223372036854775807
+)
+
+var ErrInvalidMask = errors.New("not a valid Mask")
+
+const _MaskName = "lowhigh"
+
+var _MaskMap = map[Mask]string{
+	MaskLow:  _MaskName[0:3],
+	MaskHigh: _MaskName[3:7],
+}
+
+// String implements the Stringer interface.
+func (x Mask) String() string {
+	if str, ok := _MaskMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("Mask(%d)", x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x Mask) IsValid() bool {
+	_, ok := _MaskMap[x]
+	return ok
+}
+
+var _MaskValue = map[string]Mask{
+	_MaskName[0:3]: MaskLow,
+	_MaskName[3:7]: MaskHigh,
+}
+
+// ParseMask attempts to convert a string to a Mask.
+func ParseMask(name string) (Mask, error) {
+	if x, ok := _MaskValue[name]; ok {
+		return x, nil
+	}
+	return Mask(0), fmt.Errorf("%s is %w", name, ErrInvalidMask)
+}
+
+// MarshalCBOR implements the cbor.Marshaler interface, encoding the value as a CBOR integer.
+func (x Mask) MarshalCBOR() ([]byte, error) {
+	return _MaskAppendCBORHead(nil, 0, uint64(x)), nil
+}
+
+// UnmarshalCBOR implements the cbor.Unmarshaler interface.
+func (x *Mask) UnmarshalCBOR(data []byte) error {
+	major, arg, rest, err := _MaskReadCBORHead(data)
+	if err != nil {
+		return fmt.Errorf("invalid CBOR data for Mask: %w", err)
+	}
+	if len(rest) != 0 || major != 0 {
+		return fmt.Errorf("invalid CBOR data for Mask")
+	}
+	tmp := Mask(arg)
+	if uint64(tmp) != arg || !tmp.IsValid() {
+		return fmt.Errorf("%d is %w", arg, ErrInvalidMask)
+	}
+	*x = tmp
+	return nil
+}
+
+// _MaskAppendCBORHead appends the head of a CBOR data item with the given major type and argument.
+func _MaskAppendCBORHead(b []byte, major byte, arg uint64) []byte {
+	major <<= 5
+	switch {
+	case arg < 24:
+		return append(b, major|byte(arg))
+	case arg <= math.MaxUint8:
+		return append(b, major|24, byte(arg))
+	case arg <= math.MaxUint16:
+		return binary.BigEndian.AppendUint16(append(b, major|25), uint16(arg))
+	case arg <= math.MaxUint32:
+		return binary.BigEndian.AppendUint32(append(b, major|26), uint32(arg))
+	}
+	return binary.BigEndian.AppendUint64(append(b, major|27), arg)
+}
+
+// _MaskReadCBORHead reads the head of a CBOR data item, returning its major type, argument and the remaining data.
+func _MaskReadCBORHead(data []byte) (major byte, arg uint64, rest []byte, err error) {
+	if len(data) == 0 {
+		return 0, 0, nil, io.ErrUnexpectedEOF
+	}
+	major, info := data[0]>>5, data[0]&0x1f
+	data = data[1:]
+	if info < 24 {
+		return major, uint64(info), data, nil
+	}
+	if info > 27 {
+		return 0, 0, nil, fmt.Errorf("unsupported CBOR additional information %d", info)
+	}
+	size := 1 << (info - 24)
+	if len(data) < size {
+		return 0, 0, nil, io.ErrUnexpectedEOF
+	}
+	for _, b := range data[:size] {
+		arg = arg<<8 | uint64(b)
+	}
+	return major, arg, data[size:], nil
+}
+
+// MarshalMsgpack implements the msgpack.Marshaler interface, encoding the value as a msgpack integer.
+func (x Mask) MarshalMsgpack() ([]byte, error) {
+	switch v := uint64(x); {
+	case v <= math.MaxInt8:
+		return []byte{byte(v)}, nil
+	case v <= math.MaxUint8:
+		return []byte{0xcc, byte(v)}, nil
+	case v <= math.MaxUint16:
+		return binary.BigEndian.AppendUint16([]byte{0xcd}, uint16(v)), nil
+	case v <= math.MaxUint32:
+		return binary.BigEndian.AppendUint32([]byte{0xce}, uint32(v)), nil
+	default:
+		return binary.BigEndian.AppendUint64([]byte{0xcf}, v), nil
+	}
+}
+
+// UnmarshalMsgpack implements the msgpack.Unmarshaler interface.
+func (x *Mask) UnmarshalMsgpack(data []byte) error {
+	if len(data) == 0 {
+		return fmt.Errorf("invalid msgpack data for Mask: %w", io.ErrUnexpectedEOF)
+	}
+	var v uint64
+	switch b := data[0]; {
+	case b <= 0x7f: // positive fixint
+		if len(data) != 1 {
+			return fmt.Errorf("invalid msgpack data for Mask")
+		}
+		v = uint64(b)
+	case b >= 0xcc && b <= 0xd3: // uint 8-64 and int 8-64
+		size := 1 << ((b - 0xcc) % 4)
+		if len(data) != 1+size {
+			return fmt.Errorf("invalid msgpack data for Mask")
+		}
+		for _, c := range data[1:] {
+			v = v<<8 | uint64(c)
+		}
+		if b >= 0xd0 && v>>(8*size-1) != 0 {
+			// A negative int can't be held by an unsigned enum
+			return fmt.Errorf("invalid msgpack data for Mask")
+		}
+	default:
+		return fmt.Errorf("invalid msgpack data for Mask")
+	}
+	tmp := Mask(v)
+	if uint64(tmp) != v || !tmp.IsValid() {
+		return fmt.Errorf("%d is %w", v, ErrInvalidMask)
+	}
+	*x = tmp
+	return nil
+}
+
+const (
+	// OffsetBack is a Offset of type Back.
+	OffsetBack Offset = iota + -2
+	// OffsetStill is a Offset of type Still.
+	OffsetStill Offset = iota + -1
+	// OffsetForward is a Offset of type Forward.
+	OffsetForward Offset = iota + 0
+)
+
+var ErrInvalidOffset = errors.New("not a valid Offset")
+
+const _OffsetName = "backstillforward"
+
+var _OffsetMap = map[Offset]string{
+	OffsetBack:    _OffsetName[0:4],
+	OffsetStill:   _OffsetName[4:9],
+	OffsetForward: _OffsetName[9:16],
+}
+
+// String implements the Stringer interface.
+func (x Offset) String() string {
+	if str, ok := _OffsetMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("Offset(%d)", x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x Offset) IsValid() bool {
+	_, ok := _OffsetMap[x]
+	return ok
+}
+
+var _OffsetValue = map[string]Offset{
+	_OffsetName[0:4]:  OffsetBack,
+	_OffsetName[4:9]:  OffsetStill,
+	_OffsetName[9:16]: OffsetForward,
+}
+
+// ParseOffset attempts to convert a string to a Offset.
+func ParseOffset(name string) (Offset, error) {
+	if x, ok := _OffsetValue[name]; ok {
+		return x, nil
+	}
+	return Offset(0), fmt.Errorf("%s is %w", name, ErrInvalidOffset)
+}
+
+// MarshalJSON implements the json.Marshaler interface, writing the number of the value.
+func (x Offset) MarshalJSON() ([]byte, error) {
+	return json.Marshal(int64(x))
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, accepting the number of a value.
+func (x *Offset) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var v int64
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	tmp := Offset(v)
+	if int64(tmp) != v || !tmp.IsValid() {
+		return fmt.Errorf("%d is %w", v, ErrInvalidOffset)
+	}
+	*x = tmp
+	return nil
+}
+
+var errOffsetNilPtr = errors.New("value pointer is nil") // one per type for package clashes
+
+// Scan implements the Scanner interface.
+func (x *Offset) Scan(value interface{}) (err error) {
+	if value == nil {
+		*x = Offset(0)
+		return
+	}
+
+	// sql.RawBytes is a distinct type, treat it like any other []byte.
+	if raw, ok := value.(sql.RawBytes); ok {
+		value = []byte(raw)
+	}
+
+	// A wider range of scannable types.
+	// driver.Value values at the top of the list for expediency
+	switch v := value.(type) {
+	case int64:
+		*x, err = _OffsetFromInt64(v)
+	case string:
+		*x, err = ParseOffset(v)
+		if err != nil {
+			// try parsing the integer value as a string
+			if val, verr := strconv.Atoi(v); verr == nil {
+				*x, err = _OffsetFromInt64(int64(val))
+			}
+		}
+	case []byte:
+		*x, err = ParseOffset(string(v))
+		if err != nil {
+			// try parsing the integer value as a string
+			if val, verr := strconv.Atoi(string(v)); verr == nil {
+				*x, err = _OffsetFromInt64(int64(val))
+			}
+		}
+	case Offset:
+		// An already typed value is assigned as is, once it is known to be declared
+		if !v.IsValid() {
+			return fmt.Errorf("%d is %w", v, ErrInvalidOffset)
+		}
+		*x = v
+	case *Offset:
+		if v == nil {
+			return errOffsetNilPtr
+		}
+		if !v.IsValid() {
+			return fmt.Errorf("%d is %w", *v, ErrInvalidOffset)
+		}
+		*x = *v
+	case int:
+		*x, err = _OffsetFromInt64(int64(v))
+	case int8:
+		*x, err = _OffsetFromInt64(int64(v))
+	case int16:
+		*x, err = _OffsetFromInt64(int64(v))
+	case int32:
+		*x, err = _OffsetFromInt64(int64(v))
+	case uint:
+		*x, err = _OffsetFromUint64(uint64(v))
+	case uint8:
+		*x, err = _OffsetFromUint64(uint64(v))
+	case uint16:
+		*x, err = _OffsetFromUint64(uint64(v))
+	case uint32:
+		*x, err = _OffsetFromUint64(uint64(v))
+	case uint64:
+		*x, err = _OffsetFromUint64(v)
+	case *int:
+		if v == nil {
+			return errOffsetNilPtr
+		}
+		*x, err = _OffsetFromInt64(int64(*v))
+	case *int64:
+		if v == nil {
+			return errOffsetNilPtr
+		}
+		*x, err = _OffsetFromInt64(*v)
+	case float64: // json marshals everything as a float64 if it's a number
+		*x, err = _OffsetFromFloat64(v)
+	case *float64: // json marshals everything as a float64 if it's a number
+		if v == nil {
+			return errOffsetNilPtr
+		}
+		*x, err = _OffsetFromFloat64(*v)
+	case *uint:
+		if v == nil {
+			return errOffsetNilPtr
+		}
+		*x, err = _OffsetFromUint64(uint64(*v))
+	case *uint64:
+		if v == nil {
+			return errOffsetNilPtr
+		}
+		*x, err = _OffsetFromUint64(*v)
+	case *string:
+		if v == nil {
+			return errOffsetNilPtr
+		}
+		*x, err = ParseOffset(*v)
+		if err != nil {
+			// try parsing the integer value as a string
+			if val, verr := strconv.Atoi(*v); verr == nil {
+				*x, err = _OffsetFromInt64(int64(val))
+			}
+		}
+	default:
+		return fmt.Errorf("invalid type %T for Offset", value)
+	}
+
+	return
+}
+
+// _OffsetFromInt64 converts an integer read from the database, failing when it does not fit in Offset
+// or is not one of its values.
+func _OffsetFromInt64(v int64) (Offset, error) {
+	x := Offset(v)
+	if int64(x) != v || !x.IsValid() {
+		return Offset(0), fmt.Errorf("%d is %w", v, ErrInvalidOffset)
+	}
+	return x, nil
+}
+
+// _OffsetFromUint64 converts an unsigned integer read from the database, like _OffsetFromInt64.
+func _OffsetFromUint64(v uint64) (Offset, error) {
+	if v > math.MaxInt64 {
+		return Offset(0), fmt.Errorf("%d is %w", v, ErrInvalidOffset)
+	}
+	return _OffsetFromInt64(int64(v))
+}
+
+// _OffsetFromFloat64 converts a number decoded as a float64, like _OffsetFromInt64, failing when it is not a whole number.
+func _OffsetFromFloat64(v float64) (Offset, error) {
+	if v != math.Trunc(v) || v < math.MinInt64 || v >= math.MaxInt64 {
+		return Offset(0), fmt.Errorf("%v is %w", v, ErrInvalidOffset)
+	}
+	return _OffsetFromInt64(int64(v))
+}
+
+// Value implements the driver Valuer interface.
+func (x Offset) Value() (driver.Value, error) {
+	return int64(x), nil
+}
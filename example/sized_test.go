@@ -0,0 +1,89 @@
+//go:build example
+// +build example
+
+package example
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSizedValue(t *testing.T) {
+	// driver.Value only allows int64 for integers, whatever the width of the enum
+	v, err := (FlagsRead | FlagsExec).Value()
+	require.NoError(t, err)
+	assert.IsType(t, int64(0), v)
+	assert.Equal(t, int64(5), v)
+
+	v, err = OffsetBack.Value()
+	require.NoError(t, err)
+	assert.IsType(t, int64(0), v)
+	assert.Equal(t, int64(-2), v)
+}
+
+func TestSizedScan(t *testing.T) {
+	var f Flags
+	require.NoError(t, f.Scan(int64(4)))
+	assert.Equal(t, FlagsExec, f)
+	assert.ErrorIs(t, f.Scan(int64(256)), ErrInvalidFlags, "does not fit in a uint8")
+	assert.ErrorIs(t, f.Scan(int64(-1)), ErrInvalidFlags)
+
+	var o Offset
+	require.NoError(t, o.Scan(int16(-2)))
+	assert.Equal(t, OffsetBack, o)
+	require.NoError(t, o.Scan("forward"))
+	assert.Equal(t, OffsetForward, o)
+	assert.ErrorIs(t, o.Scan(int64(1<<16-2)), ErrInvalidOffset, "wraps around to -2 in an int16")
+}
+
+func TestSizedJSON(t *testing.T) {
+	b, err := json.Marshal([]Flags{FlagsWrite, FlagsRead | FlagsWrite})
+	require.NoError(t, err)
+	assert.Equal(t, `[2,3]`, string(b))
+
+	b, err = json.Marshal(OffsetBack)
+	require.NoError(t, err)
+	assert.Equal(t, `-2`, string(b))
+
+	var o Offset
+	require.NoError(t, json.Unmarshal([]byte(`2`), &o))
+	assert.Equal(t, OffsetForward, o)
+	assert.ErrorIs(t, json.Unmarshal([]byte(`65534`), &o), ErrInvalidOffset)
+}
+
+func TestSizedCBOR(t *testing.T) {
+	// The highest bit doesn't fit in an int64
+	b, err := MaskHigh.MarshalCBOR()
+	require.NoError(t, err)
+	assert.Equal(t, []byte{0x1b, 0x80, 0, 0, 0, 0, 0, 0, 0}, b)
+
+	var m Mask
+	require.NoError(t, m.UnmarshalCBOR(b))
+	assert.Equal(t, MaskHigh, m)
+
+	assert.ErrorIs(t, m.UnmarshalCBOR([]byte{0x1b, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}), ErrInvalidMask)
+	assert.Error(t, m.UnmarshalCBOR([]byte{0x20}), "negative")
+	assert.Equal(t, MaskHigh, m)
+}
+
+func TestSizedMsgpack(t *testing.T) {
+	b, err := MaskHigh.MarshalMsgpack()
+	require.NoError(t, err)
+	assert.Equal(t, []byte{0xcf, 0x80, 0, 0, 0, 0, 0, 0, 0}, b)
+
+	var m Mask
+	require.NoError(t, m.UnmarshalMsgpack(b))
+	assert.Equal(t, MaskHigh, m)
+
+	// A positive number may come encoded as a signed int
+	require.NoError(t, m.UnmarshalMsgpack([]byte{0xd0, 0x01}))
+	assert.Equal(t, MaskLow, m)
+
+	assert.ErrorIs(t, m.UnmarshalMsgpack([]byte{0xcf, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}), ErrInvalidMask)
+	assert.Error(t, m.UnmarshalMsgpack([]byte{0xff}), "negative fixint")
+	assert.Error(t, m.UnmarshalMsgpack([]byte{0xd0, 0xff}), "negative int 8")
+	assert.Equal(t, MaskLow, m)
+}
@@ -10,10 +10,12 @@
 package example
 
 import (
+	"database/sql"
 	"database/sql/driver"
 	json "encoding/json"
 	"errors"
 	"fmt"
+	"math"
 	"strconv"
 )
 
@@ -65,13 +67,22 @@ func ParseProjectStatus(name string) (ProjectStatus, error) {
 	return ProjectStatus(0), fmt.Errorf("%s is %w", name, ErrInvalidProjectStatus)
 }
 
+// Ptr returns a pointer to a copy of x, for use in optional fields.
 func (x ProjectStatus) Ptr() *ProjectStatus {
 	return &x
 }
 
+// PtrString returns the name of the value x points to, or "" when x is nil, so optional fields print without a nil check.
+func (x *ProjectStatus) PtrString() string {
+	if x == nil {
+		return ""
+	}
+	return x.String()
+}
+
 // MarshalText implements the text marshaller method.
 func (x ProjectStatus) MarshalText() ([]byte, error) {
-	return []byte(x.String()), nil
+	return x.AppendText(nil)
 }
 
 // UnmarshalText implements the text unmarshaller method.
@@ -89,7 +100,7 @@ func (x *ProjectStatus) UnmarshalText(text []byte) error {
 // (allocating a larger slice if necessary) and returns the updated slice.
 //
 // Implementations must not retain b, nor mutate any bytes within b[:len(b)].
-func (x *ProjectStatus) AppendText(b []byte) ([]byte, error) {
+func (x ProjectStatus) AppendText(b []byte) ([]byte, error) {
 	return append(b, x.String()...), nil
 }
 
@@ -102,17 +113,22 @@ func (x *ProjectStatus) Scan(value interface{}) (err error) {
 		return
 	}
 
+	// sql.RawBytes is a distinct type, treat it like any other []byte.
+	if raw, ok := value.(sql.RawBytes); ok {
+		value = []byte(raw)
+	}
+
 	// A wider range of scannable types.
 	// driver.Value values at the top of the list for expediency
 	switch v := value.(type) {
 	case int64:
-		*x = ProjectStatus(v)
+		*x, err = _ProjectStatusFromInt64(v)
 	case string:
 		*x, err = ParseProjectStatus(v)
 		if err != nil {
 			// try parsing the integer value as a string
 			if val, verr := strconv.Atoi(v); verr == nil {
-				*x, err = ProjectStatus(val), nil
+				*x, err = _ProjectStatusFromInt64(int64(val))
 			}
 		}
 	case []byte:
@@ -120,49 +136,68 @@ func (x *ProjectStatus) Scan(value interface{}) (err error) {
 		if err != nil {
 			// try parsing the integer value as a string
 			if val, verr := strconv.Atoi(string(v)); verr == nil {
-				*x, err = ProjectStatus(val), nil
+				*x, err = _ProjectStatusFromInt64(int64(val))
 			}
 		}
 	case ProjectStatus:
+		// An already typed value is assigned as is, once it is known to be declared
+		if !v.IsValid() {
+			return fmt.Errorf("%d is %w", v, ErrInvalidProjectStatus)
+		}
 		*x = v
-	case int:
-		*x = ProjectStatus(v)
 	case *ProjectStatus:
 		if v == nil {
 			return errProjectStatusNilPtr
 		}
+		if !v.IsValid() {
+			return fmt.Errorf("%d is %w", *v, ErrInvalidProjectStatus)
+		}
 		*x = *v
+	case int:
+		*x, err = _ProjectStatusFromInt64(int64(v))
+	case int8:
+		*x, err = _ProjectStatusFromInt64(int64(v))
+	case int16:
+		*x, err = _ProjectStatusFromInt64(int64(v))
+	case int32:
+		*x, err = _ProjectStatusFromInt64(int64(v))
 	case uint:
-		*x = ProjectStatus(v)
+		*x, err = _ProjectStatusFromUint64(uint64(v))
+	case uint8:
+		*x, err = _ProjectStatusFromUint64(uint64(v))
+	case uint16:
+		*x, err = _ProjectStatusFromUint64(uint64(v))
+	case uint32:
+		*x, err = _ProjectStatusFromUint64(uint64(v))
 	case uint64:
-		*x = ProjectStatus(v)
+		*x, err = _ProjectStatusFromUint64(v)
 	case *int:
 		if v == nil {
 			return errProjectStatusNilPtr
 		}
-		*x = ProjectStatus(*v)
+		*x, err = _ProjectStatusFromInt64(int64(*v))
 	case *int64:
 		if v == nil {
 			return errProjectStatusNilPtr
 		}
-		*x = ProjectStatus(*v)
+		*x, err = _ProjectStatusFromInt64(*v)
 	case float64: // json marshals everything as a float64 if it's a number
-		*x = ProjectStatus(v)
+		*x, err = _ProjectStatusFromFloat64(v)
 	case *float64: // json marshals everything as a float64 if it's a number
 		if v == nil {
 			return errProjectStatusNilPtr
 		}
-		*x = ProjectStatus(*v)
+		*x, err = _ProjectStatusFromFloat64(*v)
 	case *uint:
 		if v == nil {
 			return errProjectStatusNilPtr
 		}
-		*x = ProjectStatus(*v)
+		*x, err = _ProjectStatusFromUint64(uint64(*v))
 	case *uint64:
 		if v == nil {
 			return errProjectStatusNilPtr
 		}
-		*x = ProjectStatus(*v)
+		*x, err = _ProjectStatusFromUint64(*v)
 	case *string:
 		if v == nil {
 			return errProjectStatusNilPtr
@@ -171,14 +206,42 @@ func (x *ProjectStatus) Scan(value interface{}) (err error) {
 		if err != nil {
 			// try parsing the integer value as a string
 			if val, verr := strconv.Atoi(*v); verr == nil {
-				*x, err = ProjectStatus(val), nil
+				*x, err = _ProjectStatusFromInt64(int64(val))
 			}
 		}
+	default:
+		return fmt.Errorf("invalid type %T for ProjectStatus", value)
 	}
 
 	return
 }
 
+// _ProjectStatusFromInt64 converts an integer read from the database, failing when it does not fit in ProjectStatus
+// or is not one of its values.
+func _ProjectStatusFromInt64(v int64) (ProjectStatus, error) {
+	x := ProjectStatus(v)
+	if int64(x) != v || !x.IsValid() {
+		return ProjectStatus(0), fmt.Errorf("%d is %w", v, ErrInvalidProjectStatus)
+	}
+	return x, nil
+}
+
+// _ProjectStatusFromUint64 converts an unsigned integer read from the database, like _ProjectStatusFromInt64.
+func _ProjectStatusFromUint64(v uint64) (ProjectStatus, error) {
+	if v > math.MaxInt64 {
+		return ProjectStatus(0), fmt.Errorf("%d is %w", v, ErrInvalidProjectStatus)
+	}
+	return _ProjectStatusFromInt64(int64(v))
+}
+
+// _ProjectStatusFromFloat64 converts a number decoded as a float64, like _ProjectStatusFromInt64, failing when it is not a whole number.
+func _ProjectStatusFromFloat64(v float64) (ProjectStatus, error) {
+	if v != math.Trunc(v) || v < math.MinInt64 || v >= math.MaxInt64 {
+		return ProjectStatus(0), fmt.Errorf("%v is %w", v, ErrInvalidProjectStatus)
+	}
+	return _ProjectStatusFromInt64(int64(v))
+}
+
 // Value implements the driver Valuer interface.
 func (x ProjectStatus) Value() (driver.Value, error) {
 	return x.String(), nil
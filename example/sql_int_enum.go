@@ -10,9 +10,11 @@
 package example
 
 import (
+	"database/sql"
 	"database/sql/driver"
 	"errors"
 	"fmt"
+	"math"
 	"strconv"
 )
 
@@ -81,17 +83,22 @@ func (x *ImageType) Scan(value interface{}) (err error) {
 		return
 	}
 
+	// sql.RawBytes is a distinct type, treat it like any other []byte.
+	if raw, ok := value.(sql.RawBytes); ok {
+		value = []byte(raw)
+	}
+
 	// A wider range of scannable types.
 	// driver.Value values at the top of the list for expediency
 	switch v := value.(type) {
 	case int64:
-		*x = ImageType(v)
+		*x, err = _ImageTypeFromInt64(v)
 	case string:
 		*x, err = ParseImageType(v)
 		if err != nil {
 			// try parsing the integer value as a string
 			if val, verr := strconv.Atoi(v); verr == nil {
-				*x, err = ImageType(val), nil
+				*x, err = _ImageTypeFromInt64(int64(val))
 			}
 		}
 	case []byte:
@@ -99,49 +106,68 @@ func (x *ImageType) Scan(value interface{}) (err error) {
 		if err != nil {
 			// try parsing the integer value as a string
 			if val, verr := strconv.Atoi(string(v)); verr == nil {
-				*x, err = ImageType(val), nil
+				*x, err = _ImageTypeFromInt64(int64(val))
 			}
 		}
 	case ImageType:
+		// An already typed value is assigned as is, once it is known to be declared
+		if !v.IsValid() {
+			return fmt.Errorf("%d is %w", v, ErrInvalidImageType)
+		}
 		*x = v
-	case int:
-		*x = ImageType(v)
 	case *ImageType:
 		if v == nil {
 			return errImageTypeNilPtr
 		}
+		if !v.IsValid() {
+			return fmt.Errorf("%d is %w", *v, ErrInvalidImageType)
+		}
 		*x = *v
+	case int:
+		*x, err = _ImageTypeFromInt64(int64(v))
+	case int8:
+		*x, err = _ImageTypeFromInt64(int64(v))
+	case int16:
+		*x, err = _ImageTypeFromInt64(int64(v))
+	case int32:
+		*x, err = _ImageTypeFromInt64(int64(v))
 	case uint:
-		*x = ImageType(v)
+		*x, err = _ImageTypeFromUint64(uint64(v))
+	case uint8:
+		*x, err = _ImageTypeFromUint64(uint64(v))
+	case uint16:
+		*x, err = _ImageTypeFromUint64(uint64(v))
+	case uint32:
+		*x, err = _ImageTypeFromUint64(uint64(v))
 	case uint64:
-		*x = ImageType(v)
+		*x, err = _ImageTypeFromUint64(v)
 	case *int:
 		if v == nil {
 			return errImageTypeNilPtr
 		}
-		*x = ImageType(*v)
+		*x, err = _ImageTypeFromInt64(int64(*v))
 	case *int64:
 		if v == nil {
 			return errImageTypeNilPtr
 		}
-		*x = ImageType(*v)
+		*x, err = _ImageTypeFromInt64(*v)
 	case float64: // json marshals everything as a float64 if it's a number
-		*x = ImageType(v)
+		*x, err = _ImageTypeFromFloat64(v)
 	case *float64: // json marshals everything as a float64 if it's a number
 		if v == nil {
 			return errImageTypeNilPtr
 		}
-		*x = ImageType(*v)
+		*x, err = _ImageTypeFromFloat64(*v)
 	case *uint:
 		if v == nil {
 			return errImageTypeNilPtr
 		}
-		*x = ImageType(*v)
+		*x, err = _ImageTypeFromUint64(uint64(*v))
 	case *uint64:
 		if v == nil {
 			return errImageTypeNilPtr
 		}
-		*x = ImageType(*v)
+		*x, err = _ImageTypeFromUint64(*v)
 	case *string:
 		if v == nil {
 			return errImageTypeNilPtr
@@ -150,14 +176,42 @@ func (x *ImageType) Scan(value interface{}) (err error) {
 		if err != nil {
 			// try parsing the integer value as a string
 			if val, verr := strconv.Atoi(*v); verr == nil {
-				*x, err = ImageType(val), nil
+				*x, err = _ImageTypeFromInt64(int64(val))
 			}
 		}
+	default:
+		return fmt.Errorf("invalid type %T for ImageType", value)
 	}
 
 	return
 }
 
+// _ImageTypeFromInt64 converts an integer read from the database, failing when it does not fit in ImageType
+// or is not one of its values.
+func _ImageTypeFromInt64(v int64) (ImageType, error) {
+	x := ImageType(v)
+	if int64(x) != v || !x.IsValid() {
+		return ImageType(0), fmt.Errorf("%d is %w", v, ErrInvalidImageType)
+	}
+	return x, nil
+}
+
+// _ImageTypeFromUint64 converts an unsigned integer read from the database, like _ImageTypeFromInt64.
+func _ImageTypeFromUint64(v uint64) (ImageType, error) {
+	if v > math.MaxInt64 {
+		return ImageType(0), fmt.Errorf("%d is %w", v, ErrInvalidImageType)
+	}
+	return _ImageTypeFromInt64(int64(v))
+}
+
+// _ImageTypeFromFloat64 converts a number decoded as a float64, like _ImageTypeFromInt64, failing when it is not a whole number.
+func _ImageTypeFromFloat64(v float64) (ImageType, error) {
+	if v != math.Trunc(v) || v < math.MinInt64 || v >= math.MaxInt64 {
+		return ImageType(0), fmt.Errorf("%v is %w", v, ErrInvalidImageType)
+	}
+	return _ImageTypeFromInt64(int64(v))
+}
+
 // Value implements the driver Valuer interface.
 func (x ImageType) Value() (driver.Value, error) {
 	return int64(x), nil
@@ -10,9 +10,11 @@
 package example
 
 import (
+	"database/sql"
 	"database/sql/driver"
 	"errors"
 	"fmt"
+	"math"
 )
 
 const (
@@ -76,65 +78,117 @@ func (x *JobState) Scan(value interface{}) (err error) {
 		return
 	}
 
+	// sql.RawBytes is a distinct type, treat it like any other []byte.
+	if raw, ok := value.(sql.RawBytes); ok {
+		value = []byte(raw)
+	}
+
 	// A wider range of scannable types.
 	// driver.Value values at the top of the list for expediency
 	switch v := value.(type) {
 	case int64:
-		*x = JobState(v)
+		*x, err = _JobStateFromInt64(v)
 	case string:
 		*x, err = ParseJobState(v)
 	case []byte:
 		*x, err = ParseJobState(string(v))
 	case JobState:
+		// An already typed value is assigned as is, once it is known to be declared
+		if !v.IsValid() {
+			return fmt.Errorf("%d is %w", v, ErrInvalidJobState)
+		}
 		*x = v
-	case int:
-		*x = JobState(v)
 	case *JobState:
 		if v == nil {
 			return errJobStateNilPtr
 		}
+		if !v.IsValid() {
+			return fmt.Errorf("%d is %w", *v, ErrInvalidJobState)
+		}
 		*x = *v
+	case int:
+		*x, err = _JobStateFromInt64(int64(v))
+	case int8:
+		*x, err = _JobStateFromInt64(int64(v))
+	case int16:
+		*x, err = _JobStateFromInt64(int64(v))
+	case int32:
+		*x, err = _JobStateFromInt64(int64(v))
 	case uint:
-		*x = JobState(v)
+		*x, err = _JobStateFromUint64(uint64(v))
+	case uint8:
+		*x, err = _JobStateFromUint64(uint64(v))
+	case uint16:
+		*x, err = _JobStateFromUint64(uint64(v))
+	case uint32:
+		*x, err = _JobStateFromUint64(uint64(v))
 	case uint64:
-		*x = JobState(v)
+		*x, err = _JobStateFromUint64(v)
 	case *int:
 		if v == nil {
 			return errJobStateNilPtr
 		}
-		*x = JobState(*v)
+		*x, err = _JobStateFromInt64(int64(*v))
 	case *int64:
 		if v == nil {
 			return errJobStateNilPtr
 		}
-		*x = JobState(*v)
+		*x, err = _JobStateFromInt64(*v)
 	case float64: // json marshals everything as a float64 if it's a number
-		*x = JobState(v)
+		*x, err = _JobStateFromFloat64(v)
 	case *float64: // json marshals everything as a float64 if it's a number
 		if v == nil {
 			return errJobStateNilPtr
 		}
-		*x = JobState(*v)
+		*x, err = _JobStateFromFloat64(*v)
 	case *uint:
 		if v == nil {
 			return errJobStateNilPtr
 		}
-		*x = JobState(*v)
+		*x, err = _JobStateFromUint64(uint64(*v))
 	case *uint64:
 		if v == nil {
 			return errJobStateNilPtr
 		}
-		*x = JobState(*v)
+		*x, err = _JobStateFromUint64(*v)
 	case *string:
 		if v == nil {
 			return errJobStateNilPtr
 		}
 		*x, err = ParseJobState(*v)
+	default:
+		return fmt.Errorf("invalid type %T for JobState", value)
 	}
 
 	return
 }
 
+// _JobStateFromInt64 converts an integer read from the database, failing when it does not fit in JobState
+// or is not one of its values.
+func _JobStateFromInt64(v int64) (JobState, error) {
+	x := JobState(v)
+	if int64(x) != v || !x.IsValid() {
+		return JobState(0), fmt.Errorf("%d is %w", v, ErrInvalidJobState)
+	}
+	return x, nil
+}
+
+// _JobStateFromUint64 converts an unsigned integer read from the database, like _JobStateFromInt64.
+func _JobStateFromUint64(v uint64) (JobState, error) {
+	if v > math.MaxInt64 {
+		return JobState(0), fmt.Errorf("%d is %w", v, ErrInvalidJobState)
+	}
+	return _JobStateFromInt64(int64(v))
+}
+
+// _JobStateFromFloat64 converts a number decoded as a float64, like _JobStateFromInt64, failing when it is not a whole number.
+func _JobStateFromFloat64(v float64) (JobState, error) {
+	if v != math.Trunc(v) || v < math.MinInt64 || v >= math.MaxInt64 {
+		return JobState(0), fmt.Errorf("%v is %w", v, ErrInvalidJobState)
+	}
+	return _JobStateFromInt64(int64(v))
+}
+
 // Value implements the driver Valuer interface.
 func (x JobState) Value() (driver.Value, error) {
 	return x.String(), nil
@@ -10,6 +10,7 @@
 package example
 
 import (
+	"database/sql"
 	"database/sql/driver"
 	"errors"
 	"fmt"
@@ -104,6 +105,11 @@ func (x *GreekGod) Scan(value interface{}) (err error) {
 		return
 	}
 
+	// sql.RawBytes is a distinct type, treat it like any other []byte.
+	if raw, ok := value.(sql.RawBytes); ok {
+		value = []byte(raw)
+	}
+
 	// A wider range of scannable types.
 	// driver.Value values at the top of the list for expediency
 	switch v := value.(type) {
@@ -119,14 +125,21 @@ func (x *GreekGod) Scan(value interface{}) (err error) {
 			*x, err = ParseGreekGod(string(v))
 		}
 	case GreekGod:
+		// An already typed value is assigned as is, once it is known to be declared
+		if !v.IsValid() {
+			return fmt.Errorf("%s is %w", string(v), ErrInvalidGreekGod)
+		}
 		*x = v
-	case int:
-		*x, err = lookupSqlIntGreekGod(int64(v))
 	case *GreekGod:
 		if v == nil {
 			return errGreekGodNilPtr
 		}
+		if !v.IsValid() {
+			return fmt.Errorf("%s is %w", string(*v), ErrInvalidGreekGod)
+		}
 		*x = *v
+	case int:
+		*x, err = lookupSqlIntGreekGod(int64(v))
 	case uint:
 		*x, err = lookupSqlIntGreekGod(int64(v))
 	case uint64:
@@ -164,7 +177,7 @@ func (x *GreekGod) Scan(value interface{}) (err error) {
 		}
 		*x, err = ParseGreekGod(*v)
 	default:
-		return errors.New("invalid type for GreekGod")
+		return fmt.Errorf("invalid type %T for GreekGod", value)
 	}
 
 	return
@@ -300,6 +313,11 @@ func (x *GreekGodCustom) Scan(value interface{}) (err error) {
 		return
 	}
 
+	// sql.RawBytes is a distinct type, treat it like any other []byte.
+	if raw, ok := value.(sql.RawBytes); ok {
+		value = []byte(raw)
+	}
+
 	// A wider range of scannable types.
 	// driver.Value values at the top of the list for expediency
 	switch v := value.(type) {
@@ -315,14 +333,21 @@ func (x *GreekGodCustom) Scan(value interface{}) (err error) {
 			*x, err = ParseGreekGodCustom(string(v))
 		}
 	case GreekGodCustom:
+		// An already typed value is assigned as is, once it is known to be declared
+		if !v.IsValid() {
+			return fmt.Errorf("%s is %w", string(v), ErrInvalidGreekGodCustom)
+		}
 		*x = v
-	case int:
-		*x, err = lookupSqlIntGreekGodCustom(int64(v))
 	case *GreekGodCustom:
 		if v == nil {
 			return errGreekGodCustomNilPtr
 		}
+		if !v.IsValid() {
+			return fmt.Errorf("%s is %w", string(*v), ErrInvalidGreekGodCustom)
+		}
 		*x = *v
+	case int:
+		*x, err = lookupSqlIntGreekGodCustom(int64(v))
 	case uint:
 		*x, err = lookupSqlIntGreekGodCustom(int64(v))
 	case uint64:
@@ -360,7 +385,7 @@ func (x *GreekGodCustom) Scan(value interface{}) (err error) {
 		}
 		*x, err = ParseGreekGodCustom(*v)
 	default:
-		return errors.New("invalid type for GreekGodCustom")
+		return fmt.Errorf("invalid type %T for GreekGodCustom", value)
 	}
 
 	return
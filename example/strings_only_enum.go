@@ -10,6 +10,7 @@
 package example
 
 import (
+	"database/sql"
 	"database/sql/driver"
 	json "encoding/json"
 	"errors"
@@ -69,14 +70,24 @@ var _StrStateValue = map[string]StrState{
 	"error":     StrStateFailed,
 }
 
+var _StrStateLowerValue = map[string]StrState{
+	"pending":   StrStatePending,
+	"running":   StrStateRunning,
+	"completed": StrStateCompleted,
+	"error":     StrStateFailed,
+}
+
 // ParseStrState attempts to convert a string to a StrState.
 func ParseStrState(name string) (StrState, error) {
 	if x, ok := _StrStateValue[name]; ok {
 		return x, nil
 	}
 	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of lowercasing a string if we don't need to.
-	if x, ok := _StrStateValue[strings.ToLower(name)]; ok {
-		return x, nil
+	// Inputs too long to lowercase into a name are not normalized at all.
+	if len(name) <= 36 {
+		if x, ok := _StrStateLowerValue[strings.ToLower(name)]; ok {
+			return x, nil
+		}
 	}
 	return StrState(""), fmt.Errorf("%s is %w", name, ErrInvalidStrState)
 }
@@ -90,13 +101,22 @@ func MustParseStrState(name string) StrState {
 	return val
 }
 
+// Ptr returns a pointer to a copy of x, for use in optional fields.
 func (x StrState) Ptr() *StrState {
 	return &x
 }
 
+// PtrString returns the name of the value x points to, or "" when x is nil, so optional fields print without a nil check.
+func (x *StrState) PtrString() string {
+	if x == nil {
+		return ""
+	}
+	return x.String()
+}
+
 // MarshalText implements the text marshaller method.
 func (x StrState) MarshalText() ([]byte, error) {
-	return []byte(string(x)), nil
+	return x.AppendText(nil)
 }
 
 // UnmarshalText implements the text unmarshaller method.
@@ -113,7 +133,7 @@ func (x *StrState) UnmarshalText(text []byte) error {
 // (allocating a larger slice if necessary) and returns the updated slice.
 //
 // Implementations must not retain b, nor mutate any bytes within b[:len(b)].
-func (x *StrState) AppendText(b []byte) ([]byte, error) {
+func (x StrState) AppendText(b []byte) ([]byte, error) {
 	return append(b, x.String()...), nil
 }
 
@@ -126,6 +146,11 @@ func (x *StrState) Scan(value interface{}) (err error) {
 		return
 	}
 
+	// sql.RawBytes is a distinct type, treat it like any other []byte.
+	if raw, ok := value.(sql.RawBytes); ok {
+		value = []byte(raw)
+	}
+
 	// A wider range of scannable types.
 	// driver.Value values at the top of the list for expediency
 	switch v := value.(type) {
@@ -134,11 +159,18 @@ func (x *StrState) Scan(value interface{}) (err error) {
 	case []byte:
 		*x, err = ParseStrState(string(v))
 	case StrState:
+		// An already typed value is assigned as is, once it is known to be declared
+		if !v.IsValid() {
+			return fmt.Errorf("%s is %w", string(v), ErrInvalidStrState)
+		}
 		*x = v
 	case *StrState:
 		if v == nil {
 			return errStrStateNilPtr
 		}
+		if !v.IsValid() {
+			return fmt.Errorf("%s is %w", string(*v), ErrInvalidStrState)
+		}
 		*x = *v
 	case *string:
 		if v == nil {
@@ -146,7 +178,7 @@ func (x *StrState) Scan(value interface{}) (err error) {
 		}
 		*x, err = ParseStrState(*v)
 	default:
-		return errors.New("invalid type for StrState")
+		return fmt.Errorf("invalid type %T for StrState", value)
 	}
 
 	return
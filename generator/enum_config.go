@@ -51,14 +51,68 @@ type EnumConfig struct {
 	ForceUpper      EnumConfigValue[bool] `json:"force_upper"`
 	NoComments      EnumConfigValue[bool] `json:"no_comments"`
 	NoParse         EnumConfigValue[bool] `json:"no_parse"`
+	Yaml            EnumConfigValue[bool] `json:"yaml"`
+	Xml             EnumConfigValue[bool] `json:"xml"`
+	Bitflag         EnumConfigValue[bool] `json:"bitflag"`
+	Toml            EnumConfigValue[bool] `json:"toml"`
+	HideDeprecated  EnumConfigValue[bool] `json:"hide_deprecated"`
+	Proto           EnumConfigValue[bool] `json:"proto"`
+	Iter            EnumConfigValue[bool] `json:"iter"`
+	Ordinal         EnumConfigValue[bool] `json:"ordinal"`
+	MarshalNumeric  EnumConfigValue[bool] `json:"marshal_numeric"`
+	GraphQL         EnumConfigValue[bool] `json:"graphql"`
+	Exhaustive      EnumConfigValue[bool] `json:"exhaustive"`
+	Binary          EnumConfigValue[bool] `json:"binary"`
+	List            EnumConfigValue[bool] `json:"list"`
+	JSONSchema      EnumConfigValue[bool] `json:"jsonschema"`
+	Zero            EnumConfigValue[bool] `json:"zero"`
+	ZeroValid       EnumConfigValue[bool] `json:"zero_valid"`
+	CBOR            EnumConfigValue[bool] `json:"cbor"`
+	Msgpack         EnumConfigValue[bool] `json:"msgpack"`
+	NoString        EnumConfigValue[bool] `json:"no_string"`
+	OrDefault       EnumConfigValue[bool] `json:"or_default"`
+	Descriptions    EnumConfigValue[bool] `json:"descriptions"`
+	LenientJSON     EnumConfigValue[bool] `json:"lenient_json"`
+	GoMap           EnumConfigValue[bool] `json:"go_map"`
+	Validate        EnumConfigValue[bool] `json:"validate"`
+	LazyParse       EnumConfigValue[bool] `json:"lazy_parse"`
+	Format          EnumConfigValue[bool] `json:"format"`
+	Strict          EnumConfigValue[bool] `json:"strict"`
+	JSONString      EnumConfigValue[bool] `json:"json_string"`
+	Batch           EnumConfigValue[bool] `json:"batch"`
+	OmitZero        EnumConfigValue[bool] `json:"omit_zero"`
+	Random          EnumConfigValue[bool] `json:"random"`
+	Predicates      EnumConfigValue[bool] `json:"predicates"`
+	ParseBytes      EnumConfigValue[bool] `json:"parse_bytes"`
+	Contains        EnumConfigValue[bool] `json:"contains"`
+	StrictMarshal   EnumConfigValue[bool] `json:"strict_marshal"`
+	JSONV2          EnumConfigValue[bool] `json:"json_v2"`
+	SetLookup       EnumConfigValue[bool] `json:"set_lookup"`
+	PtrHelper       EnumConfigValue[bool] `json:"ptr_helper"`
+	OpenAPI         EnumConfigValue[bool] `json:"openapi"`
+	FlagSet         EnumConfigValue[bool] `json:"flag_set"`
+	Slog            EnumConfigValue[bool] `json:"slog"`
+	TrimSpace       EnumConfigValue[bool] `json:"trim_space"`
+	CSV             EnumConfigValue[bool] `json:"csv"`
+	AppendJSON      EnumConfigValue[bool] `json:"append_json"`
 
 	// String options
-	Prefix EnumConfigValue[string] `json:"prefix"`
+	Prefix     EnumConfigValue[string] `json:"prefix"`
+	Suffix     EnumConfigValue[string] `json:"suffix"`
+	Header     EnumConfigValue[string] `json:"header"`
+	Template   EnumConfigValue[string] `json:"template"`
+	TrimPrefix EnumConfigValue[string] `json:"trim_prefix"`
+	Case       EnumConfigValue[string] `json:"case"`
+	Group      EnumConfigValue[string] `json:"group"`
+	ErrFmt     EnumConfigValue[string] `json:"err_fmt"`
+	Comment    EnumConfigValue[string] `json:"comment"`
+	OneOf      EnumConfigValue[string] `json:"one_of"`
+	BuildTags  EnumConfigValue[string] `json:"build_tags"`
+	Aliases    EnumConfigValue[string] `json:"aliases"`
 
 	// Slice/map options (not supported inline for simplicity)
-	// BuildTags         []string
 	// ReplacementNames  map[string]string
-	// TemplateFileNames []string
+	// TemplateFileNames []string (see Template for a single file per enum)
 }
 
 // NewEnumConfig creates a new EnumConfig with default values.
@@ -120,64 +174,219 @@ func (ec *EnumConfig) ParseAnnotation(annotation string) error {
 	return ec.setBoolOption(annotation, true)
 }
 
+// ParseTag parses the options of a struct tag style `enum:"marshal,sql,prefix=My"` declaration,
+// given without the `enum:` key and its quotes (e.g., "marshal,sql,prefix=My"), and updates the
+// EnumConfig accordingly. Options without a value are booleans set to true, `key=true` and
+// `key=false` set booleans explicitly and any other `key=value` sets a string option.
+func (ec *EnumConfig) ParseTag(tag string) error {
+	for _, option := range strings.Split(tag, ",") {
+		option = strings.TrimSpace(option)
+		if option == "" {
+			continue
+		}
+
+		key, value, hasValue := strings.Cut(option, "=")
+		key = strings.TrimSpace(key)
+		value = strings.TrimSpace(value)
+		if !hasValue {
+			if err := ec.setBoolOption(key, true); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if value == "true" || value == "false" {
+			boolValue, _ := strconv.ParseBool(value)
+			if err := ec.setBoolOption(key, boolValue); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := ec.setStringOption(key, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // setBoolOption sets a boolean option in the EnumConfig.
 func (ec *EnumConfig) setBoolOption(key string, value bool) error {
+	var field *EnumConfigValue[bool]
 	switch key {
 	case "noprefix":
-		ec.NoPrefix = EnumConfigValue[bool]{Value: value, Valid: true}
+		field = &ec.NoPrefix
 	case "noiota":
-		ec.NoIota = EnumConfigValue[bool]{Value: value, Valid: true}
+		field = &ec.NoIota
 	case "lower":
-		ec.LowercaseLookup = EnumConfigValue[bool]{Value: value, Valid: true}
+		field = &ec.LowercaseLookup
 	case "nocase":
-		ec.CaseInsensitive = EnumConfigValue[bool]{Value: value, Valid: true}
-		if value {
-			ec.LowercaseLookup = EnumConfigValue[bool]{Value: true, Valid: true} // nocase forces lower
-		}
+		field = &ec.CaseInsensitive
 	case "marshal":
-		ec.Marshal = EnumConfigValue[bool]{Value: value, Valid: true}
+		field = &ec.Marshal
 	case "sql":
-		ec.SQL = EnumConfigValue[bool]{Value: value, Valid: true}
+		field = &ec.SQL
 	case "sqlint":
-		ec.SQLInt = EnumConfigValue[bool]{Value: value, Valid: true}
+		field = &ec.SQLInt
 	case "flag":
-		ec.Flag = EnumConfigValue[bool]{Value: value, Valid: true}
+		field = &ec.Flag
 	case "names":
-		ec.Names = EnumConfigValue[bool]{Value: value, Valid: true}
+		field = &ec.Names
 	case "values":
-		ec.Values = EnumConfigValue[bool]{Value: value, Valid: true}
+		field = &ec.Values
 	case "nocamel":
-		ec.LeaveSnakeCase = EnumConfigValue[bool]{Value: value, Valid: true}
+		field = &ec.LeaveSnakeCase
 	case "ptr":
-		ec.Ptr = EnumConfigValue[bool]{Value: value, Valid: true}
+		field = &ec.Ptr
 	case "sqlnullint":
-		ec.SQLNullInt = EnumConfigValue[bool]{Value: value, Valid: true}
+		field = &ec.SQLNullInt
 	case "sqlnullstr":
-		ec.SQLNullStr = EnumConfigValue[bool]{Value: value, Valid: true}
+		field = &ec.SQLNullStr
 	case "mustparse":
-		ec.MustParse = EnumConfigValue[bool]{Value: value, Valid: true}
+		field = &ec.MustParse
 	case "forcelower":
-		ec.ForceLower = EnumConfigValue[bool]{Value: value, Valid: true}
+		field = &ec.ForceLower
 	case "forceupper":
-		ec.ForceUpper = EnumConfigValue[bool]{Value: value, Valid: true}
+		field = &ec.ForceUpper
 	case "nocomments":
-		ec.NoComments = EnumConfigValue[bool]{Value: value, Valid: true}
+		field = &ec.NoComments
 	case "noparse":
-		ec.NoParse = EnumConfigValue[bool]{Value: value, Valid: true}
+		field = &ec.NoParse
+	case "yaml":
+		field = &ec.Yaml
+	case "xml":
+		field = &ec.Xml
+	case "bitflag":
+		field = &ec.Bitflag
+	case "toml":
+		field = &ec.Toml
+	case "hidedeprecated":
+		field = &ec.HideDeprecated
+	case "proto":
+		field = &ec.Proto
+	case "iter":
+		field = &ec.Iter
+	case "ordinal":
+		field = &ec.Ordinal
+	case "marshalnumeric":
+		field = &ec.MarshalNumeric
+	case "graphql":
+		field = &ec.GraphQL
+	case "exhaustive":
+		field = &ec.Exhaustive
+	case "binary":
+		field = &ec.Binary
+	case "list":
+		field = &ec.List
+	case "jsonschema":
+		field = &ec.JSONSchema
+	case "zero":
+		field = &ec.Zero
+	case "zerovalid":
+		field = &ec.ZeroValid
+	case "cbor":
+		field = &ec.CBOR
+	case "msgpack":
+		field = &ec.Msgpack
+	case "nostring":
+		field = &ec.NoString
+	case "ordefault":
+		field = &ec.OrDefault
+	case "descriptions":
+		field = &ec.Descriptions
+	case "lenientjson":
+		field = &ec.LenientJSON
+	case "gomap":
+		field = &ec.GoMap
+	case "validate":
+		field = &ec.Validate
+	case "lazyparse":
+		field = &ec.LazyParse
+	case "format":
+		field = &ec.Format
+	case "strict":
+		field = &ec.Strict
+	case "jsonstring":
+		field = &ec.JSONString
+	case "batch":
+		field = &ec.Batch
+	case "omitzero":
+		field = &ec.OmitZero
+	case "random":
+		field = &ec.Random
+	case "predicates":
+		field = &ec.Predicates
+	case "parsebytes":
+		field = &ec.ParseBytes
+	case "contains":
+		field = &ec.Contains
+	case "strictmarshal":
+		field = &ec.StrictMarshal
+	case "jsonv2":
+		field = &ec.JSONV2
+	case "setlookup":
+		field = &ec.SetLookup
+	case "ptrhelper":
+		field = &ec.PtrHelper
+	case "openapi":
+		field = &ec.OpenAPI
+	case "flagset":
+		field = &ec.FlagSet
+	case "slog":
+		field = &ec.Slog
+	case "trimspace":
+		field = &ec.TrimSpace
+	case "csv":
+		field = &ec.CSV
+	case "appendjson":
+		field = &ec.AppendJSON
 	default:
 		return fmt.Errorf("unknown annotation: @%s", key)
 	}
 
-	return nil
+	return setConfigValue(field, key, value)
 }
 
 // setStringOption sets a string option in the EnumConfig.
 func (ec *EnumConfig) setStringOption(key, value string) error {
+	var field *EnumConfigValue[string]
 	switch key {
 	case "prefix":
-		ec.Prefix = EnumConfigValue[string]{Value: value, Valid: true}
+		field = &ec.Prefix
+	case "suffix":
+		field = &ec.Suffix
+	case "header":
+		field = &ec.Header
+	case "alias":
+		field = &ec.Aliases
+	case "template":
+		field = &ec.Template
+	case "trimprefix":
+		field = &ec.TrimPrefix
+	case "case":
+		field = &ec.Case
+	case "group":
+		field = &ec.Group
+	case "errfmt":
+		field = &ec.ErrFmt
+	case "comment":
+		field = &ec.Comment
+	case "buildtags":
+		field = &ec.BuildTags
+	case "validate":
+		// @validate:"oneof" adds the list of values for validator tags to Validate
+		field = &ec.OneOf
 	default:
 		return fmt.Errorf("unknown annotation with value: @%s=%s", key, value)
 	}
+	return setConfigValue(field, key, value)
+}
+
+// setConfigValue stores the value of an annotation, repeating an annotation is only allowed with the same value.
+func setConfigValue[T ~string | ~bool](field *EnumConfigValue[T], key string, value T) error {
+	if field.Valid && field.Value != value {
+		return fmt.Errorf("conflicting annotation: @%s:%v was already set to %v", key, value, field.Value)
+	}
+	*field = EnumConfigValue[T]{Value: value, Valid: true}
 	return nil
 }
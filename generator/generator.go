@@ -5,9 +5,13 @@ import (
 	"errors"
 	"fmt"
 	"go/ast"
+	"go/build/constraint"
 	"go/parser"
 	"go/token"
 	"net/url"
+	"path/filepath"
+	"reflect"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -38,14 +42,53 @@ type Generator struct {
 	userTemplateNames []string
 }
 
+// Output holds the files generated from the input: the main code, the encoding/json/v2 methods of its enums
+// with @jsonv2 and the enums needing a file of their own because of their @buildtags. Warnings lists the
+// enums that were skipped, for the caller to report.
+type Output struct {
+	Code     []byte
+	JSONV2   []byte
+	Tagged   []TaggedOutput
+	Warnings []string
+}
+
+// TaggedOutput is the code generated for the enums sharing a @buildtags annotation, when they can't go
+// to the main output because other enums of the same input need different build constraints.
+type TaggedOutput struct {
+	// Name is the lower case name of the first of the enums, to tell the files apart.
+	Name   string
+	Code   []byte
+	JSONV2 []byte
+}
+
+// enumOutput collects the code of the enums generated to one file, which share their build constraint.
+type enumOutput struct {
+	name      string
+	buildTags string
+	code      bytes.Buffer
+	jsonV2    bytes.Buffer
+	headers   []string
+	random    bool
+}
+
 // Enum holds data for a discovered enum in the parsed source
 type Enum struct {
 	Name    string
 	Prefix  string
+	Suffix  string
 	Type    string
 	Values  []EnumValue
+	Aliases []EnumAlias
 	Comment string
 	Config  *EnumConfig
+	// BuildTags is the build constraint of the @buildtags annotation, e.g. `linux && amd64`.
+	BuildTags string
+}
+
+// EnumAlias holds an alternate spelling that parses to one of the enum values.
+type EnumAlias struct {
+	Alias string
+	Value EnumValue
 }
 
 // EnumValue holds the individual data for each enum value within the found enum.
@@ -56,6 +99,9 @@ type EnumValue struct {
 	ValueStr     string
 	ValueInt     any
 	Comment      string
+	Deprecated   bool
+	Default      bool
+	NoDoc        bool
 }
 
 // NewGenerator is a constructor method for creating a new Generator with default
@@ -91,10 +137,16 @@ func NewGeneratorWithConfig(config GeneratorConfig) *Generator {
 	funcs["stringify"] = Stringify
 	funcs["mapify"] = Mapify
 	funcs["unmapify"] = Unmapify
+	funcs["unmapifyLower"] = UnmapifyLower
+	funcs["maxNameBytes"] = MaxNameBytes
+	funcs["oneOf"] = OneOf
+	funcs["jsonSafeNames"] = JSONSafeNames
 	funcs["namify"] = Namify
 	funcs["offset"] = Offset
 	funcs["quote"] = strconv.Quote
 	funcs["directVal"] = DirectValue
+	funcs["ordinals"] = Ordinals
+	funcs["predicate"] = g.predicateName
 
 	g.t.Funcs(funcs)
 
@@ -146,36 +198,89 @@ func ParseAliases(aliases []string) (map[string]string, error) {
 // GenerateFromFile is responsible for orchestrating the Code generation.  It results in a byte array
 // that can be written to any file desired.  It has already had goimports run on the code before being returned.
 func (g *Generator) GenerateFromFile(inputFile string) ([]byte, error) {
+	out, err := g.GenerateOutputFromFile(inputFile)
+	return out.Code, err
+}
+
+// GenerateOutputFromFile generates the enums of inputFile like GenerateFromFile, along with the other files
+// they need.
+func (g *Generator) GenerateOutputFromFile(inputFile string) (*Output, error) {
 	f, err := g.parseFile(inputFile)
 	if err != nil {
-		return nil, fmt.Errorf("generate: error parsing input file '%s': %s", inputFile, err)
+		return &Output{}, fmt.Errorf("generate: error parsing input file '%s': %s", inputFile, err)
 	}
-	return g.Generate(f)
+	return g.GenerateOutput(f)
+}
+
+// GenerateFromFiles generates the enums of several files of the same package into a single output,
+// with one header and the imports of all of them.  It has already had goimports run on the code before being returned.
+func (g *Generator) GenerateFromFiles(inputFiles ...string) ([]byte, error) {
+	out, err := g.GenerateOutputFromFiles(inputFiles...)
+	return out.Code, err
+}
+
+// GenerateOutputFromFiles generates the enums of several files like GenerateFromFiles, along with the other
+// files they need.
+func (g *Generator) GenerateOutputFromFiles(inputFiles ...string) (*Output, error) {
+	var files []*ast.File
+	for _, inputFile := range inputFiles {
+		f, err := g.parseFile(inputFile)
+		if err != nil {
+			return &Output{}, fmt.Errorf("generate: error parsing input file '%s': %s", inputFile, err)
+		}
+		if len(files) > 0 && f.Name.Name != files[0].Name.Name {
+			return &Output{}, fmt.Errorf("generate: input file '%s' is in package %s, not %s", inputFile, f.Name.Name, files[0].Name.Name)
+		}
+		files = append(files, f)
+	}
+	if len(files) == 0 {
+		return &Output{}, nil
+	}
+	return g.generate(false, files...)
 }
 
 // Generate does the heavy lifting for the code generation starting from the parsed AST file.
 func (g *Generator) Generate(f *ast.File) ([]byte, error) {
-	enums := g.inspect(f)
+	out, err := g.GenerateOutput(f)
+	return out.Code, err
+}
+
+// GenerateOutput generates the enums of the parsed AST file like Generate, along with the other files they need.
+func (g *Generator) GenerateOutput(f *ast.File) (*Output, error) {
+	return g.generate(false, f)
+}
+
+// generate writes the enums of the given files, which must share a package, to a single output.
+// With declareTypes the enum types are declared too, as they are when generating to another package.
+// The returned Output is never nil, it holds the partial code on a template error.
+func (g *Generator) generate(declareTypes bool, files ...*ast.File) (*Output, error) {
+	enums := map[string]*ast.TypeSpec{}
+	enumFiles := map[string]*ast.File{}
+	var warnings []string
+	for _, f := range files {
+		warnings = append(warnings, localEnumWarnings(f)...)
+		for name, ts := range g.inspect(f) {
+			if len(g.Types) > 0 && !slices.Contains(g.Types, name) {
+				continue
+			}
+			enums[name] = ts
+			enumFiles[name] = f
+		}
+	}
 	if len(enums) <= 0 {
-		return nil, nil
+		return &Output{Warnings: warnings}, nil
 	}
 
-	pkg := f.Name.Name
-
-	vBuff := bytes.NewBuffer([]byte{})
-	err := g.t.ExecuteTemplate(vBuff, "header", map[string]any{
-		"package":   pkg,
-		"version":   g.Version,
-		"revision":  g.Revision,
-		"buildDate": g.BuildDate,
-		"builtBy":   g.BuiltBy,
-		"buildTags": g.BuildTags,
-		"jsonpkg":   g.JSONPkg,
-	})
-	if err != nil {
-		return nil, fmt.Errorf("failed writing header: %w", err)
+	pkg := files[0].Name.Name
+	if g.Package != "" && g.Package != pkg {
+		declareTypes = true
+		pkg = g.Package
 	}
 
+	// Build constraints apply to whole files, so the enums are split by their @buildtags
+	outputs := map[string]*enumOutput{}
+	var order []*enumOutput
+
 	// Make the output more consistent by iterating over sorted keys of map
 	var keys []string
 	for key := range enums {
@@ -184,25 +289,60 @@ func (g *Generator) Generate(f *ast.File) ([]byte, error) {
 	sort.Strings(keys)
 
 	var created int
+	constants := map[string]*Enum{}
 	for _, name := range keys {
 		ts := enums[name]
 
 		// Parse the enum doc statement
-		enum, pErr := g.parseEnum(ts)
-		if pErr != nil {
-			continue
+		enum, err := g.parseEnum(ts)
+		if err != nil {
+			return &Output{}, err
+		}
+
+		// An annotation prefix or group may drop the type name, so its constants could land on another enum's names
+		for _, value := range enum.Values {
+			if value.Name == skipHolder {
+				continue
+			}
+			if other, ok := constants[value.PrefixedName]; ok && (enum.Config.Prefix.Valid || other.Config.Prefix.Valid ||
+				enum.Config.Group.Valid || other.Config.Group.Valid) {
+				return &Output{}, fmt.Errorf("enum %s: constant %s collides with a value of %s", enum.Name, value.PrefixedName, other.Name)
+			}
+			constants[value.PrefixedName] = enum
 		}
 
 		created++
 
+		out, ok := outputs[enum.BuildTags]
+		if !ok {
+			out = &enumOutput{name: strings.ToLower(enum.Name), buildTags: enum.BuildTags}
+			outputs[enum.BuildTags] = out
+			order = append(order, out)
+		}
+
+		if header := enum.Config.Header.GetString(""); header != "" && !slices.Contains(out.headers, header) {
+			out.headers = append(out.headers, header)
+		}
+		// goimports can't tell math/rand/v2 from the other rand packages, the header imports it
+		out.random = out.random || enum.Config.Random.GetBool(g.Random)
+
 		// Use enum-specific config if available, otherwise fall back to global config
 		config := enum.Config
-		
+
 		// Determine parse method generation logic
-		parseNeeded := config.MustParse.GetBool(g.MustParse) || config.Marshal.GetBool(g.Marshal) || 
-			(config.SQL.GetBool(g.SQL) || config.SQLInt.GetBool(g.SQLInt) || 
-			 config.SQLNullStr.GetBool(g.SQLNullStr) || config.SQLNullInt.GetBool(g.SQLNullInt)) || 
-			config.Flag.GetBool(g.Flag)
+		parseNeeded := config.MustParse.GetBool(g.MustParse) || config.Marshal.GetBool(g.Marshal) ||
+			(config.SQL.GetBool(g.SQL) || config.SQLInt.GetBool(g.SQLInt) ||
+				config.SQLNullStr.GetBool(g.SQLNullStr) || config.SQLNullInt.GetBool(g.SQLNullInt)) ||
+			config.Flag.GetBool(g.Flag) || config.Yaml.GetBool(g.Yaml) || config.Xml.GetBool(g.Xml) ||
+			config.Toml.GetBool(g.Toml) || config.GraphQL.GetBool(g.GraphQL) ||
+			(enum.Type == "string" && (config.Binary.GetBool(g.Binary) || config.CBOR.GetBool(g.CBOR) ||
+				config.Msgpack.GetBool(g.Msgpack))) ||
+			config.List.GetBool(g.List) || config.OrDefault.GetBool(g.OrDefault) ||
+			(enum.Type != "string" && (config.LenientJSON.GetBool(g.LenientJSON) || config.JSONString.GetBool(g.JSONString) ||
+				config.OmitZero.GetBool(g.OmitZero))) ||
+			config.Batch.GetBool(g.Batch) || config.ParseBytes.GetBool(g.ParseBytes) ||
+			config.Contains.GetBool(g.Contains) || config.JSONV2.GetBool(g.JSONV2) ||
+			config.CSV.GetBool(g.CSV)
 		generateParse := !config.NoParse.GetBool(g.NoParse) || parseNeeded
 		parseIsPublic := !config.NoParse.GetBool(g.NoParse)
 		parseName := "Parse"
@@ -211,29 +351,84 @@ func (g *Generator) Generate(f *ast.File) ([]byte, error) {
 		}
 
 		// Determine if error variable is needed
-		generateError := generateParse || (enum.Type == "string" && config.SQLInt.GetBool(g.SQLInt))
+		generateError := generateParse || (enum.Type == "string" && config.SQLInt.GetBool(g.SQLInt)) ||
+			(enum.Type != "string" && (config.Proto.GetBool(g.Proto) || config.MarshalNumeric.GetBool(g.MarshalNumeric) ||
+				config.Binary.GetBool(g.Binary) || config.CBOR.GetBool(g.CBOR) || config.Msgpack.GetBool(g.Msgpack))) ||
+			config.Ordinal.GetBool(g.Ordinal) || config.Validate.GetBool(g.Validate) || config.StrictMarshal.GetBool(g.StrictMarshal)
 
 		data := map[string]any{
-			"enum":          enum,
-			"name":          name,
-			"lowercase":     config.LowercaseLookup.GetBool(g.LowercaseLookup),
-			"nocase":        config.CaseInsensitive.GetBool(g.CaseInsensitive),
-			"nocomments":    config.NoComments.GetBool(g.NoComments),
-			"noIota":        config.NoIota.GetBool(g.NoIota),
-			"marshal":       config.Marshal.GetBool(g.Marshal),
-			"sql":           config.SQL.GetBool(g.SQL),
-			"sqlint":        config.SQLInt.GetBool(g.SQLInt),
-			"flag":          config.Flag.GetBool(g.Flag),
-			"names":         config.Names.GetBool(g.Names),
-			"ptr":           config.Ptr.GetBool(g.Ptr),
-			"values":        config.Values.GetBool(g.Values),
-			"anySQLEnabled": config.SQL.GetBool(g.SQL) || config.SQLInt.GetBool(g.SQLInt) || config.SQLNullStr.GetBool(g.SQLNullStr) || config.SQLNullInt.GetBool(g.SQLNullInt),
-			"sqlnullint":    config.SQLNullInt.GetBool(g.SQLNullInt),
-			"sqlnullstr":    config.SQLNullStr.GetBool(g.SQLNullStr),
-			"mustparse":     config.MustParse.GetBool(g.MustParse),
-			"forcelower":    config.ForceLower.GetBool(g.ForceLower),
-			"forceupper":    config.ForceUpper.GetBool(g.ForceUpper),
-			"noparse":       config.NoParse.GetBool(g.NoParse),
+			"enum":           enum,
+			"name":           name,
+			"lowercase":      config.LowercaseLookup.GetBool(g.LowercaseLookup),
+			"nocase":         config.CaseInsensitive.GetBool(g.CaseInsensitive),
+			"nocomments":     config.NoComments.GetBool(g.NoComments),
+			"noIota":         config.NoIota.GetBool(g.NoIota),
+			"marshal":        config.Marshal.GetBool(g.Marshal),
+			"sql":            config.SQL.GetBool(g.SQL),
+			"sqlint":         config.SQLInt.GetBool(g.SQLInt),
+			"flag":           config.Flag.GetBool(g.Flag),
+			"names":          config.Names.GetBool(g.Names),
+			"ptr":            config.Ptr.GetBool(g.Ptr) || config.PtrHelper.GetBool(g.PtrHelper),
+			"values":         config.Values.GetBool(g.Values),
+			"anySQLEnabled":  config.SQL.GetBool(g.SQL) || config.SQLInt.GetBool(g.SQLInt) || config.SQLNullStr.GetBool(g.SQLNullStr) || config.SQLNullInt.GetBool(g.SQLNullInt),
+			"sqlnullint":     config.SQLNullInt.GetBool(g.SQLNullInt),
+			"sqlnullstr":     config.SQLNullStr.GetBool(g.SQLNullStr),
+			"mustparse":      config.MustParse.GetBool(g.MustParse),
+			"forcelower":     config.ForceLower.GetBool(g.ForceLower),
+			"forceupper":     config.ForceUpper.GetBool(g.ForceUpper),
+			"noparse":        config.NoParse.GetBool(g.NoParse),
+			"yaml":           config.Yaml.GetBool(g.Yaml),
+			"xml":            config.Xml.GetBool(g.Xml),
+			"bitflag":        enum.Type != "string" && config.Bitflag.GetBool(g.Bitflag),
+			"bitflagIota":    isShiftIota(enum),
+			"toml":           config.Toml.GetBool(g.Toml),
+			"hidedeprecated": config.HideDeprecated.GetBool(g.HideDeprecated),
+			"proto":          config.Proto.GetBool(g.Proto),
+			"iter":           config.Iter.GetBool(g.Iter),
+			"ordinal":        config.Ordinal.GetBool(g.Ordinal),
+			"marshalnumeric": config.MarshalNumeric.GetBool(g.MarshalNumeric),
+			"graphql":        config.GraphQL.GetBool(g.GraphQL),
+			"exhaustive":     config.Exhaustive.GetBool(g.Exhaustive),
+			"binary":         config.Binary.GetBool(g.Binary),
+			"list":           config.List.GetBool(g.List),
+			"jsonschema":     config.JSONSchema.GetBool(g.JSONSchema),
+			"zero":           config.Zero.GetBool(g.Zero),
+			"defaultValue":   defaultValue(enum, config.Zero.GetBool(g.Zero)),
+			"errfmt":         config.ErrFmt.GetString(""),
+			"comment":        config.Comment.GetString(""),
+			"zerovalid":      config.ZeroValid.GetBool(g.ZeroValid),
+			"cbor":           config.CBOR.GetBool(g.CBOR),
+			"msgpack":        config.Msgpack.GetBool(g.Msgpack),
+			"nostring":       config.NoString.GetBool(g.NoString),
+			"declareType":    declareTypes,
+			"ordefault":      config.OrDefault.GetBool(g.OrDefault),
+			"descriptions":   config.Descriptions.GetBool(g.Descriptions),
+			"lenientjson":    config.LenientJSON.GetBool(g.LenientJSON),
+			"gomap":          config.GoMap.GetBool(g.GoMap),
+			"validate":       config.Validate.GetBool(g.Validate) || config.OneOf.Valid,
+			"oneof":          config.OneOf.Valid,
+			"lazyparse":      config.LazyParse.GetBool(g.LazyParse),
+			"format":         config.Format.GetBool(g.Format),
+			"strict":         config.Strict.GetBool(g.Strict),
+			// @omitzero writes int enums by name, unless they are written as numbers
+			"jsonstring": config.JSONString.GetBool(g.JSONString) ||
+				(config.OmitZero.GetBool(g.OmitZero) && !config.MarshalNumeric.GetBool(g.MarshalNumeric)),
+			"batch":         config.Batch.GetBool(g.Batch),
+			"omitzero":      config.OmitZero.GetBool(g.OmitZero),
+			"random":        config.Random.GetBool(g.Random),
+			"predicates":    config.Predicates.GetBool(g.Predicates),
+			"parsebytes":    config.ParseBytes.GetBool(g.ParseBytes),
+			"contains":      config.Contains.GetBool(g.Contains),
+			"strictmarshal": config.StrictMarshal.GetBool(g.StrictMarshal),
+			"jsonv2":        config.JSONV2.GetBool(g.JSONV2),
+			"setlookup":     config.SetLookup.GetBool(g.SetLookup),
+			"ptrhelper":     config.PtrHelper.GetBool(g.PtrHelper),
+			"openapi":       config.OpenAPI.GetBool(g.OpenAPI),
+			"flagset":       config.FlagSet.GetBool(g.FlagSet),
+			"slog":          config.Slog.GetBool(g.Slog),
+			"trimspace":     config.TrimSpace.GetBool(g.TrimSpace),
+			"csv":           config.CSV.GetBool(g.CSV),
+			"appendjson":    config.AppendJSON.GetBool(g.AppendJSON),
 			// Computed values for cleaner templates
 			"generateParse": generateParse,
 			"parseIsPublic": parseIsPublic,
@@ -246,31 +441,165 @@ func (g *Generator) Generate(f *ast.File) ([]byte, error) {
 			templateName = "enum_string"
 		}
 
-		err = g.t.ExecuteTemplate(vBuff, templateName, data)
+		t, userTemplateNames, err := g.enumTemplates(enumFiles[name], config.Template.GetString(""))
+		if err != nil {
+			return &Output{Code: out.code.Bytes()}, fmt.Errorf("failed loading template for enum: %q: %w", name, err)
+		}
+
+		err = t.ExecuteTemplate(&out.code, templateName, data)
 		if err != nil {
-			return vBuff.Bytes(), fmt.Errorf("failed writing enum data for enum: %q: %w", name, err)
+			return &Output{Code: out.code.Bytes()}, fmt.Errorf("failed writing enum data for enum: %q: %w", name, err)
 		}
 
-		for _, userTemplateName := range g.userTemplateNames {
-			err = g.t.ExecuteTemplate(vBuff, userTemplateName, data)
+		for _, userTemplateName := range userTemplateNames {
+			err = t.ExecuteTemplate(&out.code, userTemplateName, data)
 			if err != nil {
-				return vBuff.Bytes(), fmt.Errorf("failed writing enum data for enum: %q, template: %v: %w", name, userTemplateName, err)
+				return &Output{Code: out.code.Bytes()}, fmt.Errorf("failed writing enum data for enum: %q, template: %v: %w", name, userTemplateName, err)
+			}
+		}
+
+		if config.JSONV2.GetBool(g.JSONV2) {
+			if err = t.ExecuteTemplate(&out.jsonV2, "jsonv2", data); err != nil {
+				return &Output{Code: out.code.Bytes()}, fmt.Errorf("failed writing json v2 methods for enum: %q: %w", name, err)
 			}
 		}
 	}
 
 	if created < 1 {
 		// Don't save anything if we didn't actually generate any successful enums.
-		return nil, nil
+		return &Output{}, nil
+	}
+
+	// The untagged enums stay in the main output, the other constraints get outputs of their own
+	main := order[0]
+	if untagged, ok := outputs[""]; ok {
+		main = untagged
+	}
+	formatted, jsonV2, err := g.writeOutput(pkg, main)
+	if err != nil {
+		return &Output{Code: formatted}, err
+	}
+	output := &Output{Code: formatted, JSONV2: jsonV2, Warnings: warnings}
+	for _, out := range order {
+		if out == main {
+			continue
+		}
+		code, jsonV2, err := g.writeOutput(pkg, out)
+		if err != nil {
+			return &Output{Code: code}, err
+		}
+		output.Tagged = append(output.Tagged, TaggedOutput{Name: out.name, Code: code, JSONV2: jsonV2})
+	}
+	return output, nil
+}
+
+// writeOutput puts the header in front of the code of out and formats it, along with its encoding/json/v2 methods.
+func (g *Generator) writeOutput(pkg string, out *enumOutput) ([]byte, []byte, error) {
+	buildTags, err := g.buildTags(out.buildTags)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	formatted, err := imports.Process(pkg, vBuff.Bytes(), nil)
+	// The header goes last, as it holds the @header annotations of the enums
+	hBuff := bytes.NewBuffer([]byte{})
+	err = g.t.ExecuteTemplate(hBuff, "header", map[string]any{
+		"package":   pkg,
+		"version":   g.Version,
+		"revision":  g.Revision,
+		"buildDate": g.BuildDate,
+		"builtBy":   g.BuiltBy,
+		"buildTags": buildTags,
+		"jsonpkg":   g.JSONPkg,
+		"headers":   out.headers,
+		"random":    out.random,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed writing header: %w", err)
+	}
+	hBuff.Write(out.code.Bytes())
+
+	formatted, err := imports.Process(pkg, hBuff.Bytes(), nil)
+	if err != nil {
+		return formatted, nil, fmt.Errorf("generate: error formatting code %s\n\n%s", err, hBuff.String())
+	}
+
+	if out.jsonV2.Len() == 0 {
+		return formatted, nil, nil
+	}
+	jsonV2, err := g.generateJSONV2(pkg, buildTags, out.jsonV2.Bytes())
+	return formatted, jsonV2, err
+}
+
+// buildTags returns the build tags of a generated file, the global ones combined with the constraint
+// of its @buildtags into a single expression, as a file can only have one //go:build line.
+func (g *Generator) buildTags(enumTags string) ([]string, error) {
+	if enumTags == "" {
+		return g.BuildTags, nil
+	}
+	var parts []string
+	for _, tag := range g.BuildTags {
+		parts = append(parts, "("+tag+")")
+	}
+	expr, err := constraint.Parse("//go:build " + strings.Join(append(parts, "("+enumTags+")"), " && "))
 	if err != nil {
-		err = fmt.Errorf("generate: error formatting code %s\n\n%s", err, vBuff.String())
+		return nil, fmt.Errorf("generate: invalid build tags %q: %w", g.BuildTags, err)
+	}
+	return []string{expr.String()}, nil
+}
+
+// generateJSONV2 puts the header of the encoding/json/v2 file in front of its methods. They go to a file of
+// their own, as the package only builds with GOEXPERIMENT=jsonv2.
+func (g *Generator) generateJSONV2(pkg string, buildTags []string, methods []byte) ([]byte, error) {
+	buff := bytes.NewBuffer([]byte{})
+	err := g.t.ExecuteTemplate(buff, "jsonv2_header", map[string]any{
+		"package":   pkg,
+		"version":   g.Version,
+		"revision":  g.Revision,
+		"buildDate": g.BuildDate,
+		"builtBy":   g.BuiltBy,
+		"buildTags": buildTags,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed writing json v2 header: %w", err)
+	}
+	buff.Write(methods)
+
+	formatted, err := imports.Process(pkg, buff.Bytes(), nil)
+	if err != nil {
+		err = fmt.Errorf("generate: error formatting json v2 code %s\n\n%s", err, buff.String())
 	}
 	return formatted, err
 }
 
+// enumTemplates returns the templates to generate an enum with, along with the user templates to run after it.
+// The @template file of an enum is parsed on top of a copy of the built-in templates, so its definitions
+// replace the built-in ones of the same name (such as "string_method") for that enum only.
+func (g *Generator) enumTemplates(f *ast.File, fileName string) (*template.Template, []string, error) {
+	if fileName == "" {
+		return g.t, g.userTemplateNames, nil
+	}
+	if !filepath.IsAbs(fileName) {
+		fileName = filepath.Join(filepath.Dir(g.fileSet.Position(f.Package).Filename), fileName)
+	}
+
+	t, err := g.t.Clone()
+	if err != nil {
+		return nil, nil, err
+	}
+	if t, err = t.ParseFiles(fileName); err != nil {
+		return nil, nil, err
+	}
+
+	names := slices.Clone(g.userTemplateNames)
+	for _, ut := range t.Templates() {
+		if _, ok := g.knownTemplates[ut.Name()]; !ok {
+			names = append(names, ut.Name())
+		}
+	}
+	sort.Strings(names)
+	return t, names, nil
+}
+
 // updateTemplates will update the lookup map for validation checks that are
 // allowed within the template engine.
 func (g *Generator) updateTemplates() {
@@ -297,38 +626,73 @@ func (g *Generator) parseEnum(ts *ast.TypeSpec) (*Enum, error) {
 
 	enum.Name = ts.Name.Name
 	enum.Type = fmt.Sprintf("%s", ts.Type)
-	
+
+	// Methods can't be declared on an alias, the enum needs a type of its own
+	if ts.Assign.IsValid() {
+		err := fmt.Errorf("enum %s: ENUM can't be declared on a type alias, use `type %s %s` instead", enum.Name, enum.Name, enum.Type)
+		return nil, err
+	}
+
 	// Extract annotations and enum declaration
 	annotations, enumDecl := extractAnnotationsAndEnumDecl(ts.Doc.List)
-	
+
 	// Parse annotations
 	for _, annotation := range annotations {
-		if err := enum.Config.ParseAnnotation(annotation); err != nil {
-			fmt.Printf("Warning: failed to parse annotation %q: %v\n", annotation, err)
+		parse := enum.Config.ParseAnnotation
+		if annotation.Tag {
+			parse = enum.Config.ParseTag
+		}
+		if err := parse(annotation.Text); err != nil {
+			position := g.fileSet.Position(annotation.Pos)
+			position.Line += annotation.Line
+			position.Column = 0
+			err = fmt.Errorf("%s: type %s: %w", position, enum.Name, err)
+			return nil, err
 		}
 	}
-	
+
+	if enum.Config.JSONString.GetBool(g.JSONString) && enum.Config.MarshalNumeric.GetBool(g.MarshalNumeric) {
+		err := fmt.Errorf("enum %s: @jsonstring and @marshalnumeric are incompatible: JSON can't hold both the name and the number", enum.Name)
+		return nil, err
+	}
+
 	// Determine prefix based on config (local overrides global)
 	noPrefix := enum.Config.NoPrefix.GetBool(g.NoPrefix)
 	if !noPrefix {
 		enum.Prefix = ts.Name.Name
 	}
-	
+
+	// Apply the group shared by related enums, `@group:"Order"` gives OrderStatusPending
+	enum.Prefix = enum.Config.Group.GetString("") + enum.Prefix
+	typePrefix := enum.Prefix
+
 	// Apply global prefix if set
 	if g.Prefix != "" {
 		enum.Prefix = g.Prefix + enum.Prefix
 	}
-	
-	// Apply annotation prefix if set (overrides everything)
+
+	// Apply annotation prefix if set (overrides the global prefix). With @noprefix only the
+	// literal prefix is kept, so `@noprefix @prefix:"X"` gives XRed instead of XColorRed.
 	if prefix := enum.Config.Prefix.GetString(""); prefix != "" {
-		enum.Prefix = prefix + ts.Name.Name
+		prefix, err := expandNameTemplate("prefix", prefix, enum.Name)
+		if err != nil {
+			return nil, err
+		}
+		enum.Prefix = prefix + typePrefix
+	}
+
+	// Apply annotation suffix if set
+	suffix, err := expandNameTemplate("suffix", enum.Config.Suffix.GetString(""), enum.Name)
+	if err != nil {
+		return nil, err
 	}
+	enum.Suffix = suffix
 
 	commentPreEnumDecl, _, _ := strings.Cut(ts.Doc.Text(), `ENUM(`)
 	enum.Comment = strings.TrimSpace(commentPreEnumDecl)
 
 	if enumDecl == "" {
-		return nil, errors.New("failed parsing enum")
+		return nil, fmt.Errorf("enum %s: failed parsing the ENUM declaration", enum.Name)
 	}
 
 	values := strings.Split(strings.TrimSuffix(strings.TrimPrefix(enumDecl, `ENUM(`), `)`), `,`)
@@ -336,12 +700,24 @@ func (g *Generator) parseEnum(ts *ast.TypeSpec) (*Enum, error) {
 		data     any
 		unsigned bool
 	)
+	bitflag := enum.Type != "string" && enum.Config.Bitflag.GetBool(g.Bitflag)
 	if strings.HasPrefix(enum.Type, "u") {
 		data = uint64(0)
 		unsigned = true
 	} else {
 		data = int64(0)
 	}
+	if bitflag {
+		data = increment(data)
+	} else if enum.Config.FlagSet.GetBool(false) {
+		err := fmt.Errorf("enum %s: @flagset needs a @bitflag enum", enum.Name)
+		return nil, err
+	}
+	convertCase, err := caseConverter(enum.Config.Case.GetString(""))
+	if err != nil {
+		err = fmt.Errorf("enum %s: %w", enum.Name, err)
+		return nil, err
+	}
 	for _, value := range values {
 		var comment string
 
@@ -354,10 +730,14 @@ func (g *Generator) parseEnum(ts *ast.TypeSpec) (*Enum, error) {
 			value = value[:commentStartIndex]
 		}
 
+		// Trim and store the [marker] flags of the value
+		value, markers := cutValueMarkers(value)
+
 		// Make sure to leave out any empty parts
 		if value != "" {
 			rawName := value
 			valueStr := value
+			text := ""
 
 			if strings.Contains(value, `=`) {
 				// Get the value specified and set the data to that value.
@@ -374,19 +754,21 @@ func (g *Generator) parseEnum(ts *ast.TypeSpec) (*Enum, error) {
 						if q := identifyQuoted(dataVal); q != "" {
 							valueStr = trimQuotes(q, dataVal)
 						}
+					} else if q := identifyQuoted(dataVal); q != "" {
+						// A quoted string only replaces the text of the value, the data keeps counting up
+						text = trimQuotes(q, dataVal)
+						valueStr = text
 					} else if unsigned {
 						newData, err := strconv.ParseUint(dataVal, 0, 64)
 						if err != nil {
-							err = fmt.Errorf("failed parsing the data part of enum value '%s': %w", value, err)
-							fmt.Println(err)
+							err = fmt.Errorf("enum %s: failed parsing the data part of enum value '%s': %w", enum.Name, value, err)
 							return nil, err
 						}
 						data = newData
 					} else {
 						newData, err := strconv.ParseInt(dataVal, 0, 64)
 						if err != nil {
-							err = fmt.Errorf("failed parsing the data part of enum value '%s': %w", value, err)
-							fmt.Println(err)
+							err = fmt.Errorf("enum %s: failed parsing the data part of enum value '%s': %w", enum.Name, value, err)
 							return nil, err
 						}
 						data = newData
@@ -398,27 +780,227 @@ func (g *Generator) parseEnum(ts *ast.TypeSpec) (*Enum, error) {
 			}
 			rawName = strings.TrimSpace(rawName)
 			valueStr = strings.TrimSpace(valueStr)
+			if trim := enum.Config.TrimPrefix.GetString(""); trim != "" && rawName != skipHolder {
+				trimmed, ok := strings.CutPrefix(rawName, trim)
+				if !ok || trimmed == "" {
+					err := fmt.Errorf("enum value '%s' of %s does not start with the @trimprefix %q", rawName, enum.Name, trim)
+					return nil, err
+				}
+				if valueStr == rawName {
+					valueStr = trimmed
+				}
+				rawName = trimmed
+			}
 			name := cases.Title(language.Und, cases.NoLower).String(rawName)
 			prefixedName := name
 			if name != skipHolder {
-				prefixedName = enum.Prefix + name
+				prefixedName = enum.Prefix + name + enum.Suffix
 				prefixedName = g.sanitizeValue(prefixedName)
 				if !g.LeaveSnakeCase {
 					prefixedName = snakeToCamelCase(prefixedName)
 				}
 			}
 
+			if text != "" {
+				rawName = text
+			} else if convertCase != nil && name != skipHolder {
+				cased := convertCase(rawName)
+				if valueStr == rawName {
+					valueStr = cased
+				}
+				rawName = cased
+			}
+
+			if name != skipHolder && !fitsType(data, enum.Type) {
+				err := fmt.Errorf("enum value '%s' of %s overflows its %s type with %v", rawName, enum.Name, enum.Type, data)
+				return nil, err
+			}
+
+			// A value declared twice would give duplicate keys in the generated maps
+			if name != skipHolder {
+				for _, v := range enum.Values {
+					var err error
+					switch {
+					case v.Name == skipHolder:
+					case v.RawName == rawName:
+						err = fmt.Errorf("enum %s: duplicate value '%s'", enum.Name, rawName)
+					case enum.Type == "string" && v.ValueStr == valueStr:
+						err = fmt.Errorf("enum %s: values '%s' and '%s' both have the string %q", enum.Name, v.RawName, rawName, valueStr)
+					case enum.Type != "string" && v.ValueInt == data:
+						err = fmt.Errorf("enum %s: values '%s' and '%s' both have the number %v", enum.Name, v.RawName, rawName, data)
+					}
+					if err != nil {
+						return nil, err
+					}
+				}
+			}
+
+			if name != skipHolder && slices.ContainsFunc(enum.Values, func(v EnumValue) bool { return v.PrefixedName == prefixedName }) {
+				err := fmt.Errorf("enum %s: value '%s' collides with another value on constant name %s", enum.Name, rawName, prefixedName)
+				return nil, err
+			}
+
 			ev := EnumValue{Name: name, RawName: rawName, PrefixedName: prefixedName, ValueStr: valueStr, ValueInt: data, Comment: comment}
+			for _, marker := range markers {
+				switch marker {
+				case "deprecated":
+					ev.Deprecated = true
+				case "default":
+					if slices.ContainsFunc(enum.Values, func(v EnumValue) bool { return v.Default }) {
+						err := fmt.Errorf("enum %s has more than one [default] value", enum.Name)
+						return nil, err
+					}
+					ev.Default = true
+				case "nodoc":
+					ev.NoDoc = true
+				default:
+					err := fmt.Errorf("enum %s: unknown marker [%s] on enum value '%s'", enum.Name, marker, rawName)
+					return nil, err
+				}
+			}
 			enum.Values = append(enum.Values, ev)
-			data = increment(data)
+			if bitflag {
+				data = shiftLeft(data)
+			} else {
+				data = increment(data)
+			}
 		}
 	}
 
+	if enum.Config.Predicates.GetBool(g.Predicates) {
+		for _, value := range Ordinals(*enum) {
+			if predicate := g.predicateName(value.Name); predicate == "IsValid" || predicate == "IsZero" {
+				err := fmt.Errorf("enum %s: the @predicates method of value '%s' clashes with %s()", enum.Name, value.RawName, predicate)
+				return nil, err
+			}
+		}
+	}
+
+	if oneOf := enum.Config.OneOf.GetString(""); enum.Config.OneOf.Valid && oneOf != "oneof" {
+		err := fmt.Errorf(`enum %s: @validate:%q is not supported, use @validate or @validate:"oneof"`, enum.Name, oneOf)
+		return nil, err
+	}
+
+	if tags := enum.Config.BuildTags.GetString(""); tags != "" {
+		buildTags, err := parseBuildTags(tags)
+		if err != nil {
+			err = fmt.Errorf("enum %s: @buildtags:%q is not a valid build constraint: %w", enum.Name, tags, err)
+			return nil, err
+		}
+		enum.BuildTags = buildTags
+	}
+
+	if errFmt := enum.Config.ErrFmt.GetString(""); errFmt != "" {
+		if verbs := countVerbs(errFmt); verbs != 2 {
+			err := fmt.Errorf("enum %s: @errfmt:%q needs 2 verbs, for the input and the type name, it has %d", enum.Name, errFmt, verbs)
+			return nil, err
+		}
+	}
+
+	if aliases := enum.Config.Aliases.GetString(""); aliases != "" {
+		parsed, err := parseEnumAliases(enum, aliases)
+		if err != nil {
+			return nil, err
+		}
+		enum.Aliases = parsed
+	}
+
 	// fmt.Printf("###\nENUM: %+v\n###\n", enum)
 
 	return enum, nil
 }
 
+// expandNameTemplate expands the text/template of a @prefix or @suffix annotation,
+// `@prefix:"{{.Type}}V"` gives the prefix StatusV to the Status enum.
+func expandNameTemplate(key, text, typeName string) (string, error) {
+	if !strings.Contains(text, "{{") {
+		return text, nil
+	}
+	t, err := template.New(key).Parse(text)
+	if err != nil {
+		return "", fmt.Errorf("enum %s: @%s:%q is not a valid template: %w", typeName, key, text, err)
+	}
+	var b strings.Builder
+	if err = t.Execute(&b, struct{ Type string }{Type: typeName}); err != nil {
+		return "", fmt.Errorf("enum %s: @%s:%q is not a valid template: %w", typeName, key, text, err)
+	}
+	return b.String(), nil
+}
+
+// parseBuildTags turns the comma separated tags of a @buildtags annotation, which must all be satisfied,
+// into a //go:build expression: `linux,amd64` gives `linux && amd64`. A tag may be an expression itself.
+func parseBuildTags(tags string) (string, error) {
+	var parts []string
+	for _, tag := range strings.Split(tags, ",") {
+		if tag = strings.TrimSpace(tag); tag == "" {
+			return "", errors.New("empty tag")
+		}
+		parts = append(parts, "("+tag+")")
+	}
+	expr, err := constraint.Parse("//go:build " + strings.Join(parts, " && "))
+	if err != nil {
+		return "", err
+	}
+	return expr.String(), nil
+}
+
+// countVerbs returns the number of formatting verbs of a fmt format, `%%` being a literal percent sign.
+func countVerbs(format string) int {
+	var verbs int
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' {
+			continue
+		}
+		i++
+		if i < len(format) && format[i] == '%' {
+			continue
+		}
+		verbs++
+	}
+	return verbs
+}
+
+// parseEnumAliases parses the `alias=value` pairs of an @alias annotation, making sure
+// every alias points to a declared value of the enum.
+func parseEnumAliases(enum *Enum, aliases string) ([]EnumAlias, error) {
+	var parsed []EnumAlias
+	for _, pair := range strings.Split(aliases, ",") {
+		alias, target, ok := strings.Cut(pair, "=")
+		alias, target = strings.TrimSpace(alias), strings.TrimSpace(target)
+		if !ok || alias == "" || target == "" {
+			return nil, fmt.Errorf("invalid alias %q for enum %s, must be in the format \"alias=value\"", pair, enum.Name)
+		}
+		var found bool
+		for _, val := range enum.Values {
+			if val.Name != skipHolder && (val.RawName == target || val.ValueStr == target) {
+				parsed = append(parsed, EnumAlias{Alias: alias, Value: val})
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("alias %q for enum %s refers to unknown value %q", alias, enum.Name, target)
+		}
+	}
+	return parsed, nil
+}
+
+// cutValueMarkers removes the trailing `[marker]` flags from an enum value,
+// e.g. `legacy [deprecated]`, and returns them lowercased.
+func cutValueMarkers(value string) (string, []string) {
+	var markers []string
+	value = strings.TrimSpace(value)
+	for strings.HasSuffix(value, "]") {
+		start := strings.LastIndex(value, "[")
+		if start < 0 {
+			break
+		}
+		markers = append([]string{strings.ToLower(strings.TrimSpace(value[start+1 : len(value)-1]))}, markers...)
+		value = strings.TrimSpace(value[:start])
+	}
+	return value, markers
+}
+
 func identifyQuoted(s string) string {
 	s = strings.TrimSpace(s)
 	if strings.HasPrefix(s, `"`) && strings.HasSuffix(s, `"`) {
@@ -434,6 +1016,33 @@ func trimQuotes(q, s string) string {
 	return strings.TrimPrefix(strings.TrimSuffix(strings.TrimSpace(s), q), q)
 }
 
+// fitsType reports whether the data of an enum value fits in the integer type the enum is declared with.
+// int, uint and the 64 bits types are not checked, the size of int and uint depends on the platform.
+func fitsType(d any, typ string) bool {
+	var bits uint
+	switch typ {
+	case "int8", "uint8", "byte":
+		bits = 8
+	case "int16", "uint16":
+		bits = 16
+	case "int32", "uint32", "rune":
+		bits = 32
+	default:
+		return true
+	}
+	switch v := d.(type) {
+	case uint64:
+		return v < 1<<bits
+	case int64:
+		// byte values are parsed as signed like any type not starting with a u
+		if typ == "byte" {
+			return v >= 0 && v < 1<<bits
+		}
+		return v >= -1<<(bits-1) && v < 1<<(bits-1)
+	}
+	return true
+}
+
 func increment(d any) any {
 	switch v := d.(type) {
 	case uint64:
@@ -444,6 +1053,49 @@ func increment(d any) any {
 	return d
 }
 
+func shiftLeft(d any) any {
+	switch v := d.(type) {
+	case uint64:
+		return v << 1
+	case int64:
+		return v << 1
+	}
+	return d
+}
+
+// defaultValue returns the value marked as [default], falling back to the first declared
+// value when @zero is set. It returns nil when the enum has no default value.
+func defaultValue(e *Enum, zero bool) *EnumValue {
+	values := Ordinals(*e)
+	for i := range values {
+		if values[i].Default {
+			return &values[i]
+		}
+	}
+	if zero && len(values) > 0 {
+		return &values[0]
+	}
+	return nil
+}
+
+// isShiftIota checks whether every value of a bitflag enum is exactly 1 << its index,
+// so the constants can be declared with `1 << iota`.
+func isShiftIota(e *Enum) bool {
+	for i, val := range e.Values {
+		switch v := val.ValueInt.(type) {
+		case uint64:
+			if v != uint64(1)<<i {
+				return false
+			}
+		case int64:
+			if v != int64(1)<<i {
+				return false
+			}
+		}
+	}
+	return true
+}
+
 func unescapeComment(comment string) string {
 	val, err := url.QueryUnescape(comment)
 	if err != nil {
@@ -485,6 +1137,72 @@ func (g *Generator) sanitizeValue(value string) string {
 	return nameBuilder.String()
 }
 
+// predicateName returns the name of the @predicates method of a value, `in_progress` gives IsInProgress.
+func (g *Generator) predicateName(name string) string {
+	return "Is" + snakeToCamelCase(g.sanitizeValue(name))
+}
+
+// caseConverter returns the function deriving the string of a value from its name for a @case mode,
+// or nil when no mode is set.
+func caseConverter(mode string) (func(string) string, error) {
+	title := cases.Title(language.Und)
+	switch mode {
+	case "":
+		return nil, nil
+	case "snake":
+		return func(s string) string { return strings.ToLower(strings.Join(splitWords(s), "_")) }, nil
+	case "kebab":
+		return func(s string) string { return strings.ToLower(strings.Join(splitWords(s), "-")) }, nil
+	case "screaming":
+		return func(s string) string { return strings.ToUpper(strings.Join(splitWords(s), "_")) }, nil
+	case "camel", "pascal":
+		return func(s string) string {
+			words := splitWords(s)
+			for i, word := range words {
+				if i == 0 && mode == "camel" {
+					words[i] = strings.ToLower(word)
+				} else {
+					words[i] = title.String(word)
+				}
+			}
+			return strings.Join(words, "")
+		}, nil
+	}
+	return nil, fmt.Errorf("unknown @case %q, must be one of snake, kebab, camel, pascal or screaming", mode)
+}
+
+// splitWords breaks a name into its words on `_`, `-` and spaces as well as on camel case humps,
+// keeping acronyms together (`HTTPStatus` gives `HTTP` and `Status`).
+func splitWords(s string) []string {
+	var (
+		words []string
+		word  []rune
+	)
+	runes := []rune(s)
+	for i, r := range runes {
+		if r == '_' || r == '-' || r == ' ' {
+			if len(word) > 0 {
+				words = append(words, string(word))
+				word = nil
+			}
+			continue
+		}
+		if len(word) > 0 && unicode.IsUpper(r) {
+			prev := word[len(word)-1]
+			nextIsLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextIsLower) {
+				words = append(words, string(word))
+				word = nil
+			}
+		}
+		word = append(word, r)
+	}
+	if len(word) > 0 {
+		words = append(words, string(word))
+	}
+	return words
+}
+
 func snakeToCamelCase(value string) string {
 	parts := strings.Split(value, "_")
 	title := cases.Title(language.Und, cases.NoLower)
@@ -575,12 +1293,25 @@ func getEnumDeclFromComments(comments []*ast.Comment) string {
 func parseLinePart(line string) (paramLevel int, trimmed string) {
 	trimmed = line
 	comment := ""
+	next := ""
 	if idx := strings.Index(line, parseCommentPrefix); idx >= 0 {
 		trimmed = line[:idx]
-		comment = "//" + url.QueryEscape(strings.TrimSpace(line[idx+2:]))
+		text := line[idx+2:]
+		// A single line can describe several values, `a // first, b // second`, so a following
+		// comment starts a new value after the last comma before it.
+		if nextIdx := strings.Index(text, " "+parseCommentPrefix); nextIdx >= 0 {
+			if comma := strings.LastIndex(text[:nextIdx], ","); comma >= 0 && strings.TrimSpace(text[comma+1:nextIdx]) != "" {
+				text, next = text[:comma], text[comma+1:]
+			}
+		}
+		comment = "//" + url.QueryEscape(strings.TrimSpace(text))
 	}
 	trimmed = trimAllTheThings(trimmed)
 	trimmed += comment
+	if next != "" {
+		_, nextTrimmed := parseLinePart(next)
+		trimmed += "," + nextTrimmed
+	}
 	opens := strings.Count(line, `(`)
 	closes := strings.Count(line, `)`)
 	if opens > 0 {
@@ -622,6 +1353,9 @@ func (g *Generator) inspect(f ast.Node) map[string]*ast.TypeSpec {
 	// Inspect the AST and find all structs.
 	ast.Inspect(f, func(n ast.Node) bool {
 		switch x := n.(type) {
+		case *ast.FuncDecl, *ast.FuncLit:
+			// Methods can't be declared on types local to a function, so their enums are left out
+			return false
 		case *ast.GenDecl:
 			copyGenDeclCommentsToSpecs(x)
 		case *ast.Ident:
@@ -649,6 +1383,31 @@ func (g *Generator) inspect(f ast.Node) map[string]*ast.TypeSpec {
 	return enums
 }
 
+// localEnumWarnings describes the enums declared inside the functions of f, which inspect skips.
+func localEnumWarnings(f ast.Node) []string {
+	var warnings []string
+	ast.Inspect(f, func(n ast.Node) bool {
+		switch x := n.(type) {
+		case *ast.FuncDecl, *ast.FuncLit:
+			// The function literals nested in x are walked along with it
+			ast.Inspect(x, func(n ast.Node) bool {
+				if decl, ok := n.(*ast.GenDecl); ok && decl.Tok == token.TYPE {
+					copyGenDeclCommentsToSpecs(decl)
+					for _, spec := range decl.Specs {
+						if ts, ok := spec.(*ast.TypeSpec); ok && isTypeSpecEnum(ts) {
+							warnings = append(warnings, fmt.Sprintf("enum %s: declared inside a function, only package level types can have methods, skipping it", ts.Name.Name))
+						}
+					}
+				}
+				return true
+			})
+			return false
+		}
+		return true
+	})
+	return warnings
+}
+
 // copyDocsToSpecs will take the GenDecl level documents and copy them
 // to the children Type and Value specs.  I think this is actually working
 // around a bug in the AST, but it works for now.
@@ -686,37 +1445,53 @@ func isTypeSpecEnum(ts *ast.TypeSpec) bool {
 	return isEnum
 }
 
-// extractAnnotationsAndEnumDecl extracts annotations (lines starting with @) and the ENUM declaration
+// enumAnnotation is a single @annotation along with the comment it was found in.
+type enumAnnotation struct {
+	Text string
+	Pos  token.Pos // position of the comment holding the annotation
+	Line int       // line of the annotation within a multi line comment
+	Tag  bool      // the text holds the options of an `enum:"..."` tag rather than an @annotation
+}
+
+// extractAnnotationsAndEnumDecl extracts annotations (lines starting with @ or `enum:"` tags) and the ENUM declaration
 // from the comment list. Returns the annotations and the enum declaration string.
-func extractAnnotationsAndEnumDecl(comments []*ast.Comment) ([]string, string) {
-	var annotations []string
+func extractAnnotationsAndEnumDecl(comments []*ast.Comment) ([]enumAnnotation, string) {
+	var annotations []enumAnnotation
 	var enumDecl string
-	
+
 	for _, comment := range comments {
 		lines := breakCommentIntoLines(comment)
-		for _, line := range lines {
+		for lineIndex, line := range lines {
 			trimmedLine := strings.TrimSpace(line)
-			
+
 			// Skip empty lines
 			if trimmedLine == "" {
 				continue
 			}
-			
+
 			// Check if this line contains ENUM(
 			if strings.Contains(trimmedLine, "ENUM(") {
 				// Use the existing getEnumDeclFromComments function to get the full declaration
 				enumDecl = getEnumDeclFromComments(comments)
 				break
 			}
-			
+
+			// Check if this line holds the options as an `enum:"marshal,prefix=My"` tag
+			if strings.HasPrefix(trimmedLine, `enum:"`) {
+				if options, ok := reflect.StructTag(trimmedLine).Lookup("enum"); ok {
+					annotations = append(annotations, enumAnnotation{Text: options, Pos: comment.Pos(), Line: lineIndex, Tag: true})
+					continue
+				}
+			}
+
 			// Check if this line contains annotations
 			if strings.Contains(trimmedLine, "@") {
 				// Split by whitespace to get individual annotations
 				// This handles cases like "@para1 @param2 @para3"
-				parts := strings.Fields(trimmedLine)
+				parts := splitAnnotations(trimmedLine)
 				for _, part := range parts {
 					if strings.HasPrefix(part, "@") {
-						annotations = append(annotations, part)
+						annotations = append(annotations, enumAnnotation{Text: part, Pos: comment.Pos(), Line: lineIndex})
 					}
 				}
 			}
@@ -725,6 +1500,37 @@ func extractAnnotationsAndEnumDecl(comments []*ast.Comment) ([]string, string) {
 			break
 		}
 	}
-	
+
 	return annotations, enumDecl
 }
+
+// splitAnnotations splits a comment line on whitespace, keeping quoted annotation values
+// such as @header:"Some text" in one piece.
+func splitAnnotations(line string) []string {
+	var parts []string
+	var current strings.Builder
+	var quote rune
+	for _, r := range line {
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			}
+			current.WriteRune(r)
+		case (r == '"' || r == '\'') && strings.HasPrefix(current.String(), "@"):
+			quote = r
+			current.WriteRune(r)
+		case unicode.IsSpace(r):
+			if current.Len() > 0 {
+				parts = append(parts, current.String())
+				current.Reset()
+			}
+		default:
+			current.WriteRune(r)
+		}
+	}
+	if current.Len() > 0 {
+		parts = append(parts, current.String())
+	}
+	return parts
+}
@@ -250,7 +250,7 @@ func Test118EnumParseFailure(t *testing.T) {
 	assert.Nil(t, err, "Error parsing no struct input")
 
 	output, err := g.Generate(f)
-	assert.Nil(t, err, "Error generating formatted code")
+	assert.EqualError(t, err, "enum Animal: failed parsing the ENUM declaration")
 	assert.Empty(t, string(output))
 	if false { // Debugging statement
 		fmt.Println(string(output))
@@ -270,7 +270,7 @@ func Test118UintInvalidParsing(t *testing.T) {
 	assert.Nil(t, err, "Error parsing no struct input")
 
 	output, err := g.Generate(f)
-	assert.Nil(t, err, "Error generating formatted code")
+	assert.EqualError(t, err, `enum Animal: failed parsing the data part of enum value 'a=-1': strconv.ParseUint: parsing "-1": invalid syntax`)
 	assert.Empty(t, string(output))
 	if false { // Debugging statement
 		fmt.Println(string(output))
@@ -290,7 +290,7 @@ func Test118IntInvalidParsing(t *testing.T) {
 	assert.Nil(t, err, "Error parsing no struct input")
 
 	output, err := g.Generate(f)
-	assert.Nil(t, err, "Error generating formatted code")
+	assert.EqualError(t, err, `enum Animal: failed parsing the data part of enum value 'a=c': strconv.ParseInt: parsing "c": invalid syntax`)
 	assert.Empty(t, string(output))
 	if false { // Debugging statement
 		fmt.Println(string(output))
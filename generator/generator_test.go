@@ -4,6 +4,9 @@ import (
 	"errors"
 	"fmt"
 	"go/parser"
+	"os"
+	"path/filepath"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -351,7 +354,7 @@ func TestEnumParseFailure(t *testing.T) {
 	assert.Nil(t, err, "Error parsing no struct input")
 
 	output, err := g.Generate(f)
-	assert.Nil(t, err, "Error generating formatted code")
+	assert.EqualError(t, err, "enum Animal: failed parsing the ENUM declaration")
 	assert.Empty(t, string(output))
 	if false { // Debugging statement
 		fmt.Println(string(output))
@@ -371,7 +374,7 @@ func TestUintInvalidParsing(t *testing.T) {
 	assert.Nil(t, err, "Error parsing no struct input")
 
 	output, err := g.Generate(f)
-	assert.Nil(t, err, "Error generating formatted code")
+	assert.EqualError(t, err, `enum Animal: failed parsing the data part of enum value 'a=-1': strconv.ParseUint: parsing "-1": invalid syntax`)
 	assert.Empty(t, string(output))
 	if false { // Debugging statement
 		fmt.Println(string(output))
@@ -391,7 +394,7 @@ func TestIntInvalidParsing(t *testing.T) {
 	assert.Nil(t, err, "Error parsing no struct input")
 
 	output, err := g.Generate(f)
-	assert.Nil(t, err, "Error generating formatted code")
+	assert.EqualError(t, err, `enum Animal: failed parsing the data part of enum value 'a=c': strconv.ParseInt: parsing "c": invalid syntax`)
 	assert.Empty(t, string(output))
 	if false { // Debugging statement
 		fmt.Println(string(output))
@@ -989,3 +992,1604 @@ type Greek string
 	assert.Contains(t, outputStr, "var ErrInvalidGreek")
 	assert.Contains(t, outputStr, "lookupSqlIntGreek")
 }
+
+// TestYamlAnnotationWithIntEnum tests that @yaml adds the yaml marshalling methods to int enums
+func TestYamlAnnotationWithIntEnum(t *testing.T) {
+	input := `package test
+
+// @yaml
+// ENUM(one, two, three)
+type Number int
+`
+	g := NewGenerator()
+	f, err := parser.ParseFile(g.fileSet, "test.go", input, parser.ParseComments)
+	require.NoError(t, err)
+
+	output, err := g.Generate(f)
+	require.NoError(t, err)
+	require.NotNil(t, output)
+
+	outputStr := string(output)
+
+	assert.Contains(t, outputStr, "func (x Number) MarshalYAML() (interface{}, error)")
+	assert.Contains(t, outputStr, "func (x *Number) UnmarshalYAML(unmarshal func(interface{}) error) error")
+	assert.Contains(t, outputStr, "ParseNumber(name)")
+}
+
+// TestValuesDeclarationOrder tests that Values() of int enums follows the declaration order, like Names()
+func TestValuesDeclarationOrder(t *testing.T) {
+	input := `package test
+
+// @values
+// ENUM(high=30, low=10, medium=20)
+type Level int
+`
+	g := NewGenerator()
+	f, err := parser.ParseFile(g.fileSet, "test.go", input, parser.ParseComments)
+	require.NoError(t, err)
+
+	output, err := g.Generate(f)
+	require.NoError(t, err)
+	require.NotNil(t, output)
+
+	assert.Regexp(t, `(?s)func LevelValues\(\) \[\]Level \{\s+return \[\]Level\{\s+LevelHigh,\s+LevelLow,\s+LevelMedium,\s+\}`, string(output))
+}
+
+// TestNamesHonorForceUpper tests that Names() of int enums uses the same forced casing as String()
+func TestNamesHonorForceUpper(t *testing.T) {
+	input := `package test
+
+// @names @forceupper
+// ENUM(one, two)
+type Number int
+`
+	g := NewGenerator()
+	f, err := parser.ParseFile(g.fileSet, "test.go", input, parser.ParseComments)
+	require.NoError(t, err)
+
+	output, err := g.Generate(f)
+	require.NoError(t, err)
+	require.NotNil(t, output)
+
+	outputStr := string(output)
+
+	assert.Contains(t, outputStr, `const _NumberName = "ONETWO"`)
+	assert.Contains(t, outputStr, "func NumberNames() []string")
+	assert.Regexp(t, `var _NumberNames = \[\]string\{\s+_NumberName\[0:3\],\s+_NumberName\[3:6\],\s+\}`, outputStr)
+}
+
+// TestBitflagWithExplicitValues tests that explicit values on a bitflag enum are rendered directly
+func TestBitflagWithExplicitValues(t *testing.T) {
+	input := `package test
+
+// @bitflag
+// ENUM(a, b, c=8, d)
+type Flags uint
+`
+	g := NewGenerator()
+	f, err := parser.ParseFile(g.fileSet, "test.go", input, parser.ParseComments)
+	require.NoError(t, err)
+
+	output, err := g.Generate(f)
+	require.NoError(t, err)
+	require.NotNil(t, output)
+
+	outputStr := string(output)
+
+	assert.NotContains(t, outputStr, "1 << iota")
+	assert.Regexp(t, `FlagsA Flags = 1\n`, outputStr)
+	assert.Regexp(t, `FlagsB Flags = 2\n`, outputStr)
+	assert.Regexp(t, `FlagsC Flags = 8\n`, outputStr)
+	assert.Regexp(t, `FlagsD Flags = 16\n`, outputStr)
+	assert.Contains(t, outputStr, "const _FlagsMask = FlagsA | FlagsB | FlagsC | FlagsD")
+	assert.Contains(t, outputStr, "func (x Flags) Has(other Flags) bool")
+}
+
+// TestAliasAnnotationIntEnum tests that @alias adds the alias to the parse lookup of an int enum
+func TestAliasAnnotationIntEnum(t *testing.T) {
+	input := `package test
+
+// @alias:"colour=color" @nocase
+// ENUM(shade, color)
+type Attr int
+`
+	g := NewGenerator()
+	f, err := parser.ParseFile(g.fileSet, "test.go", input, parser.ParseComments)
+	require.NoError(t, err)
+
+	output, err := g.Generate(f)
+	require.NoError(t, err)
+	require.NotNil(t, output)
+
+	outputStr := string(output)
+
+	assert.Regexp(t, `"colour":\s+AttrColor,`, outputStr)
+	assert.Contains(t, outputStr, `const _AttrName = "shadecolor"`)
+}
+
+// TestAliasAnnotationUnknownValue tests that an alias to an undeclared value fails the generation
+func TestAliasAnnotationUnknownValue(t *testing.T) {
+	input := `package test
+
+// @alias:"colour=colr"
+// ENUM(shade, color)
+type Attr int
+`
+	g := NewGenerator()
+	f, err := parser.ParseFile(g.fileSet, "test.go", input, parser.ParseComments)
+	require.NoError(t, err)
+
+	output, err := g.Generate(f)
+	assert.EqualError(t, err, `alias "colour" for enum Attr refers to unknown value "colr"`)
+	assert.Empty(t, string(output))
+}
+
+// TestLookupsUsePackageMaps tests that String and Parse read from the package level lookup maps
+func TestLookupsUsePackageMaps(t *testing.T) {
+	input := `package test
+
+// @nocase
+// ENUM(InProgress, Done)
+type State int
+`
+	g := NewGenerator()
+	f, err := parser.ParseFile(g.fileSet, "test.go", input, parser.ParseComments)
+	require.NoError(t, err)
+
+	output, err := g.Generate(f)
+	require.NoError(t, err)
+	require.NotNil(t, output)
+
+	outputStr := string(output)
+
+	// Value to name
+	assert.Contains(t, outputStr, "var _StateMap = map[State]string{")
+	assert.Contains(t, outputStr, "if str, ok := _StateMap[x]; ok {")
+	// Name to value, with the lowercase keys stored for case insensitive parsing
+	assert.Contains(t, outputStr, "var _StateValue = map[string]State{")
+	assert.Regexp(t, `strings.ToLower\(_StateName\[0:10\]\):\s+StateInProgress,`, outputStr)
+	assert.Contains(t, outputStr, "if x, ok := _StateValue[name]; ok {")
+	assert.NotContains(t, outputStr, "switch name")
+}
+
+// TestDeprecatedValueMarker tests that a [deprecated] value gets a deprecation notice on its constant
+func TestDeprecatedValueMarker(t *testing.T) {
+	input := `package test
+
+// ENUM(active, legacy [deprecated], archived)
+type Account int
+
+// @nocomments
+// ENUM(on, off [deprecated])
+type Toggle string
+`
+	g := NewGenerator()
+	f, err := parser.ParseFile(g.fileSet, "test.go", input, parser.ParseComments)
+	require.NoError(t, err)
+
+	output, err := g.Generate(f)
+	require.NoError(t, err)
+	require.NotNil(t, output)
+
+	outputStr := string(output)
+
+	assert.Contains(t, outputStr, "\t// AccountLegacy is a Account of type Legacy.\n\t//\n\t// Deprecated: AccountLegacy is deprecated.\n\tAccountLegacy\n")
+	assert.Contains(t, outputStr, "\t// AccountArchived is a Account of type Archived.\n\tAccountArchived\n")
+	assert.Contains(t, outputStr, "\tToggleOn Toggle = \"on\"\n\t// Deprecated: ToggleOff is deprecated.\n\tToggleOff Toggle = \"off\"\n")
+}
+
+// TestUnknownValueMarker tests that an unknown [marker] fails the generation
+func TestUnknownValueMarker(t *testing.T) {
+	input := `package test
+
+// ENUM(active, legacy [obsolete])
+type Account int
+`
+	g := NewGenerator()
+	f, err := parser.ParseFile(g.fileSet, "test.go", input, parser.ParseComments)
+	require.NoError(t, err)
+
+	output, err := g.Generate(f)
+	assert.EqualError(t, err, "enum Account: unknown marker [obsolete] on enum value 'legacy'")
+	assert.Empty(t, string(output))
+}
+
+// TestCustomStringRepresentation tests that a quoted string overrides the derived text of a value
+func TestCustomStringRepresentation(t *testing.T) {
+	input := `package test
+	// ENUM(InProgress="in-progress", in_review="in-review", done)
+	type State string
+
+	// ENUM(InProgress="in-progress", Done)
+	type Phase int
+	`
+	g := NewGenerator(WithoutSnakeToCamel())
+	f, err := parser.ParseFile(g.fileSet, "TestRequiredErrors", input, parser.ParseComments)
+	assert.Nil(t, err, "Error parsing no struct input")
+
+	output, err := g.Generate(f)
+	assert.Nil(t, err, "Error generating formatted code")
+	assert.Contains(t, string(output), "StateInProgress State = \"in-progress\"")
+	assert.Contains(t, string(output), "StateIn_review State = \"in-review\"")
+	assert.Contains(t, string(output), "StateDone State = \"done\"")
+	assert.Contains(t, string(output), "PhaseInProgress Phase = iota")
+	assert.Contains(t, string(output), "const _PhaseName = \"in-progressDone\"")
+	if false { // Debugging statement
+		fmt.Println(string(output))
+	}
+}
+
+// TestProtoAnnotation tests that @proto only adds conversions to int enums
+func TestProtoAnnotation(t *testing.T) {
+	input := `package test
+	// @proto @noparse
+	// ENUM(unknown, low=10)
+	type Priority int32
+
+	// @proto
+	// ENUM(low, high)
+	type Level string
+	`
+	g := NewGenerator()
+	f, err := parser.ParseFile(g.fileSet, "TestRequiredErrors", input, parser.ParseComments)
+	assert.Nil(t, err, "Error parsing no struct input")
+
+	output, err := g.Generate(f)
+	assert.Nil(t, err, "Error generating formatted code")
+	assert.Contains(t, string(output), "func (x Priority) ToProto() int32 {")
+	assert.Contains(t, string(output), "func PriorityFromProto(v int32) (Priority, error) {")
+	assert.Contains(t, string(output), "var ErrInvalidPriority = errors.New(\"not a valid Priority\")")
+	assert.NotContains(t, string(output), "func ParsePriority(")
+	assert.NotContains(t, string(output), "func (x Level) ToProto()")
+	if false { // Debugging statement
+		fmt.Println(string(output))
+	}
+}
+
+// TestCaseInsensitiveWithoutLowercaseLookup tests that @nocase keeps the exact lookup table as declared
+func TestCaseInsensitiveWithoutLowercaseLookup(t *testing.T) {
+	input := `package test
+	// @nocase
+	// ENUM(InProgress, Done)
+	type Stage int
+
+	// @nocase
+	// ENUM(InProgress, Done)
+	type Step string
+	`
+	g := NewGenerator()
+	f, err := parser.ParseFile(g.fileSet, "TestRequiredErrors", input, parser.ParseComments)
+	assert.Nil(t, err, "Error parsing no struct input")
+
+	output, err := g.Generate(f)
+	assert.Nil(t, err, "Error generating formatted code")
+	assert.Regexp(t, `var _StageValue = map\[string\]Stage\{\s+_StageName\[0:10\]:\s+StageInProgress,\s+_StageName\[10:14\]:\s+StageDone,\s+\}`, string(output))
+	assert.Regexp(t, `strings.ToLower\(_StageName\[0:10\]\):\s+StageInProgress,`, string(output))
+	assert.Contains(t, string(output), "if x, ok := _StageLowerValue[strings.ToLower(name)]; ok {")
+	assert.Regexp(t, `var _StepValue = map\[string\]Step\{\s+"InProgress":\s+StepInProgress,\s+"Done":\s+StepDone,\s+\}`, string(output))
+	assert.Regexp(t, `"inprogress":\s+StepInProgress,`, string(output))
+	if false { // Debugging statement
+		fmt.Println(string(output))
+	}
+}
+
+// TestIterAnnotation tests that @iter yields the values in declaration order, leaving out skipped ones
+func TestIterAnnotation(t *testing.T) {
+	input := `package test
+	// @iter
+	// ENUM(high=30, _, low=10)
+	type Priority int
+	`
+	g := NewGenerator()
+	f, err := parser.ParseFile(g.fileSet, "TestRequiredErrors", input, parser.ParseComments)
+	assert.Nil(t, err, "Error parsing no struct input")
+
+	output, err := g.Generate(f)
+	assert.Nil(t, err, "Error generating formatted code")
+	assert.Contains(t, string(output), "func PriorityAll() iter.Seq[Priority] {")
+	assert.Contains(t, string(output), "range []Priority{\n\t\t\tPriorityHigh,\n\t\t\tPriorityLow,\n\t\t} {")
+	if false { // Debugging statement
+		fmt.Println(string(output))
+	}
+}
+
+// TestNoStringAnnotation tests that @nostring leaves out String while keeping the lookup maps
+func TestNoStringAnnotation(t *testing.T) {
+	input := `package test
+	// @nostring @marshal
+	// ENUM(low, high)
+	type Priority int
+
+	// @nostring
+	// ENUM(red, blue)
+	type Color string
+	`
+	g := NewGenerator()
+	f, err := parser.ParseFile(g.fileSet, "TestRequiredErrors", input, parser.ParseComments)
+	assert.Nil(t, err, "Error parsing no struct input")
+
+	output, err := g.Generate(f)
+	assert.Nil(t, err, "Error generating formatted code")
+	assert.NotContains(t, string(output), "String() string")
+	assert.Equal(t, 1, strings.Count(string(output), "var _PriorityMap = "))
+	assert.Contains(t, string(output), "func (x Priority) IsValid() bool {")
+	assert.Contains(t, string(output), "func (x Priority) MarshalText() ([]byte, error) {")
+	assert.Contains(t, string(output), "func (x Color) IsValid() bool {")
+	if false { // Debugging statement
+		fmt.Println(string(output))
+	}
+}
+
+// TestPackageOption tests that the generated output uses the configured package and declares the enum types
+func TestPackageOption(t *testing.T) {
+	input := `package test
+	// ENUM(low, high)
+	type Priority int
+
+	// ENUM(red, blue)
+	type Color string
+	`
+	g := NewGenerator(WithPackage("colors"), WithMarshal())
+	f, err := parser.ParseFile(g.fileSet, "TestRequiredErrors", input, parser.ParseComments)
+	assert.Nil(t, err, "Error parsing no struct input")
+
+	output, err := g.Generate(f)
+	assert.Nil(t, err, "Error generating formatted code")
+	assert.Contains(t, string(output), "\npackage colors\n")
+	assert.Contains(t, string(output), "\ntype Priority int\n")
+	assert.Contains(t, string(output), "\ntype Color string\n")
+
+	// The types are only declared when the package actually changes
+	g = NewGenerator(WithPackage("test"))
+	output, err = g.Generate(f)
+	assert.Nil(t, err, "Error generating formatted code")
+	assert.Contains(t, string(output), "\npackage test\n")
+	assert.NotContains(t, string(output), "type Priority int")
+	if false { // Debugging statement
+		fmt.Println(string(output))
+	}
+}
+
+// TestTemplateAnnotation tests that @template replaces the built-in String method of a single enum
+func TestTemplateAnnotation(t *testing.T) {
+	tmpl := filepath.Join(t.TempDir(), "custom.tmpl")
+	require.NoError(t, os.WriteFile(tmpl, []byte(`{{- define "string_method"}}
+// String returns a fixed text.
+func (x {{.enum.Name}}) String() string {
+	return "custom"
+}
+{{- end}}`), 0o600))
+
+	input := `package test
+	// @template:"` + tmpl + `"
+	// ENUM(low, high)
+	type Priority int
+
+	// ENUM(red, blue)
+	type Color string
+	`
+	g := NewGenerator()
+	f, err := parser.ParseFile(g.fileSet, "TestRequiredErrors", input, parser.ParseComments)
+	assert.Nil(t, err, "Error parsing no struct input")
+
+	output, err := g.Generate(f)
+	assert.Nil(t, err, "Error generating formatted code")
+	assert.Contains(t, string(output), "func (x Priority) String() string {\n\treturn \"custom\"\n}")
+	assert.Contains(t, string(output), "func (x Color) String() string {\n\treturn string(x)\n}")
+	if false { // Debugging statement
+		fmt.Println(string(output))
+	}
+
+	// A missing template file fails the generation
+	f, err = parser.ParseFile(g.fileSet, "TestRequiredErrors", strings.Replace(input, tmpl, "missing.tmpl", 1), parser.ParseComments)
+	assert.Nil(t, err, "Error parsing no struct input")
+	_, err = g.Generate(f)
+	assert.ErrorContains(t, err, "failed loading template for enum: \"Priority\"")
+}
+
+// TestDescriptionsAnnotation tests that single line inline comments become doc comments and descriptions
+func TestDescriptionsAnnotation(t *testing.T) {
+	input := `package test
+	// @descriptions
+	// ENUM(active // user is active, banned // user is banned, see https://example.com, guest)
+	type UserState int
+	`
+	g := NewGenerator()
+	f, err := parser.ParseFile(g.fileSet, "TestRequiredErrors", input, parser.ParseComments)
+	assert.Nil(t, err, "Error parsing no struct input")
+
+	output, err := g.Generate(f)
+	assert.Nil(t, err, "Error generating formatted code")
+	assert.Contains(t, string(output), "// user is active\n\tUserStateActive UserState = iota\n")
+	assert.Contains(t, string(output), "case UserStateActive:\n\t\treturn \"user is active\"\n")
+	assert.Contains(t, string(output), "return \"user is banned, see https://example.com, guest\"")
+	if false { // Debugging statement
+		fmt.Println(string(output))
+	}
+}
+
+// TestLiteralValues tests that hexadecimal, binary and octal custom values are parsed
+func TestLiteralValues(t *testing.T) {
+	input := `package test
+	// ENUM(a=0x01, b=0x02, c=0b0100, d=0o10)
+	type Flag int
+	`
+	g := NewGenerator(WithNoIota())
+	f, err := parser.ParseFile(g.fileSet, "TestRequiredErrors", input, parser.ParseComments)
+	assert.Nil(t, err, "Error parsing no struct input")
+
+	output, err := g.Generate(f)
+	assert.Nil(t, err, "Error generating formatted code")
+	assert.Contains(t, string(output), "FlagB Flag = 2\n")
+	assert.Contains(t, string(output), "FlagC Flag = 4\n")
+	assert.Contains(t, string(output), "FlagD Flag = 8\n")
+	if false { // Debugging statement
+		fmt.Println(string(output))
+	}
+}
+
+// TestTrimPrefixAnnotation tests that @trimprefix requires every value to carry the prefix
+func TestTrimPrefixAnnotation(t *testing.T) {
+	input := `package test
+	// @trimprefix:"color_"
+	// ENUM(color_red, green)
+	type Color string
+	`
+	g := NewGenerator()
+	f, err := parser.ParseFile(g.fileSet, "TestRequiredErrors", input, parser.ParseComments)
+	assert.Nil(t, err, "Error parsing no struct input")
+
+	_, err = g.parseEnum(g.inspect(f)["Color"])
+	assert.EqualError(t, err, `enum value 'green' of Color does not start with the @trimprefix "color_"`)
+}
+
+// TestSkippedValues tests that `_` advances the counter without declaring a value
+func TestSkippedValues(t *testing.T) {
+	input := `package test
+	// @values
+	// ENUM(a, _, c)
+	type Skip int
+	`
+	g := NewGenerator(WithNoIota())
+	f, err := parser.ParseFile(g.fileSet, "TestRequiredErrors", input, parser.ParseComments)
+	assert.Nil(t, err, "Error parsing no struct input")
+
+	output, err := g.Generate(f)
+	assert.Nil(t, err, "Error generating formatted code")
+	assert.Len(t, regexp.MustCompile(`\n\tSkip\w+ Skip = `).FindAllString(string(output), -1), 2)
+	assert.Contains(t, string(output), "SkipA Skip = 0\n")
+	assert.Contains(t, string(output), "SkipC Skip = 2\n")
+	assert.Contains(t, string(output), "return []Skip{\n\t\tSkipA,\n\t\tSkipC,\n\t}")
+	assert.NotContains(t, string(output), `"_"`)
+	if false { // Debugging statement
+		fmt.Println(string(output))
+	}
+}
+
+// TestCaseAnnotation tests the string representations derived by each @case mode
+func TestCaseAnnotation(t *testing.T) {
+	tests := map[string][]string{
+		"snake":     {"in_progress", "http_status", "done"},
+		"kebab":     {"in-progress", "http-status", "done"},
+		"camel":     {"inProgress", "httpStatus", "done"},
+		"pascal":    {"InProgress", "HttpStatus", "Done"},
+		"screaming": {"IN_PROGRESS", "HTTP_STATUS", "DONE"},
+	}
+	for mode, expected := range tests {
+		t.Run(mode, func(t *testing.T) {
+			input := `package test
+			// @case:"` + mode + `"
+			// ENUM(InProgress, HTTPStatus, done)
+			type Phase int
+
+			// @case:"` + mode + `"
+			// ENUM(in_progress, httpStatus, Done)
+			type State string
+			`
+			g := NewGenerator()
+			f, err := parser.ParseFile(g.fileSet, "TestRequiredErrors", input, parser.ParseComments)
+			require.Nil(t, err, "Error parsing no struct input")
+
+			output, err := g.Generate(f)
+			require.Nil(t, err, "Error generating formatted code")
+			assert.Contains(t, string(output), "const _PhaseName = \""+strings.Join(expected, "")+"\"")
+			assert.Contains(t, string(output), "PhaseInProgress Phase = iota")
+			assert.Contains(t, string(output), "StateInProgress State = \""+expected[0]+"\"")
+			assert.Contains(t, string(output), "StateHttpStatus State = \""+expected[1]+"\"")
+			assert.Contains(t, string(output), "StateDone State = \""+expected[2]+"\"")
+		})
+	}
+
+	input := `package test
+	// @case:"title"
+	// ENUM(a, b)
+	type Phase int
+	`
+	g := NewGenerator()
+	f, err := parser.ParseFile(g.fileSet, "TestRequiredErrors", input, parser.ParseComments)
+	require.Nil(t, err, "Error parsing no struct input")
+	_, err = g.parseEnum(g.inspect(f)["Phase"])
+	assert.EqualError(t, err, `enum Phase: unknown @case "title", must be one of snake, kebab, camel, pascal or screaming`)
+}
+
+// TestLazyParseAnnotation tests that @lazyparse defers building the parse maps to a sync.Once
+func TestLazyParseAnnotation(t *testing.T) {
+	input := `package test
+	// @lazyparse @nocase
+	// ENUM(Red, Green)
+	type Color int
+
+	// @lazyparse
+	// ENUM(small, large)
+	type Size string
+	`
+	g := NewGenerator(WithNoParse())
+	f, err := parser.ParseFile(g.fileSet, "TestRequiredErrors", input, parser.ParseComments)
+	require.Nil(t, err, "Error parsing no struct input")
+
+	output, err := g.Generate(f)
+	require.Nil(t, err, "Error generating formatted code")
+	assert.Contains(t, string(output), "_ColorValueOnce  sync.Once")
+	assert.Contains(t, string(output), "_ColorLowerValue map[string]Color")
+	assert.NotContains(t, string(output), "var _ColorValue =")
+	assert.Contains(t, string(output), "_SizeValueOnce sync.Once")
+	assert.Regexp(t, `(?s)func \(x Size\) IsValid\(\) bool \{\s+_SizeInitValue\(\)`, string(output))
+}
+
+// TestNoPrefixWithPrefixAnnotation tests that @noprefix drops the type name but keeps the @prefix literal
+func TestNoPrefixWithPrefixAnnotation(t *testing.T) {
+	input := `package test
+	// @noprefix @prefix:"X"
+	// ENUM(red, green)
+	type Color int
+
+	// @prefix:"X"
+	// ENUM(red, green)
+	type Shade string
+	`
+	g := NewGenerator()
+	f, err := parser.ParseFile(g.fileSet, "TestRequiredErrors", input, parser.ParseComments)
+	require.Nil(t, err, "Error parsing no struct input")
+
+	output, err := g.Generate(f)
+	require.Nil(t, err, "Error generating formatted code")
+	assert.Contains(t, string(output), "XRed Color = iota")
+	assert.Contains(t, string(output), "XShadeRed Shade = \"red\"")
+}
+
+// TestPrefixCollisions tests that constant names colliding because of the prefix annotations are reported
+func TestPrefixCollisions(t *testing.T) {
+	input := `package test
+	// @noprefix @prefix:"X"
+	// ENUM(red, green)
+	type Color int
+
+	// @noprefix @prefix:"X"
+	// ENUM(blue, red)
+	type Shade string
+	`
+	g := NewGenerator()
+	f, err := parser.ParseFile(g.fileSet, "TestRequiredErrors", input, parser.ParseComments)
+	require.Nil(t, err, "Error parsing no struct input")
+
+	_, err = g.Generate(f)
+	require.Error(t, err)
+	assert.Equal(t, "enum Shade: constant XRed collides with a value of Color", err.Error())
+
+	input = `package test
+	// @noprefix @prefix:"X"
+	// ENUM(dark_red, darkRed)
+	type Color int
+	`
+	g = NewGenerator()
+	f, err = parser.ParseFile(g.fileSet, "TestRequiredErrors", input, parser.ParseComments)
+	require.Nil(t, err, "Error parsing no struct input")
+
+	enum, err := g.parseEnum(g.inspect(f)["Color"])
+	require.Error(t, err)
+	assert.Nil(t, enum)
+	assert.Equal(t, "enum Color: value 'darkRed' collides with another value on constant name XDarkRed", err.Error())
+}
+
+// TestParseTag tests that the options of an `enum:"..."` tag are set like the matching annotations
+func TestParseTag(t *testing.T) {
+	config := NewEnumConfig()
+	require.NoError(t, config.ParseTag("marshal,prefix=My"))
+	assert.True(t, config.Marshal.GetBool(false))
+	assert.Equal(t, "My", config.Prefix.GetString(""))
+	assert.False(t, config.SQL.Valid)
+
+	config = NewEnumConfig()
+	require.NoError(t, config.ParseTag(" sql=false, nocase ,"))
+	assert.False(t, config.SQL.GetBool(true))
+	assert.True(t, config.CaseInsensitive.GetBool(false))
+
+	assert.EqualError(t, NewEnumConfig().ParseTag("marshl"), "unknown annotation: @marshl")
+	assert.EqualError(t, NewEnumConfig().ParseTag("marshal,marshal=false"), "conflicting annotation: @marshal:false was already set to true")
+
+	input := `package test
+	// enum:"marshal,prefix=My"
+	// ENUM(red, green)
+	type Color int
+
+	// enum:"nocse"
+	// ENUM(on, off)
+	type Status int
+	`
+	g := NewGenerator()
+	f, err := parser.ParseFile(g.fileSet, "tags.go", input, parser.ParseComments)
+	require.Nil(t, err, "Error parsing no struct input")
+
+	enum, err := g.parseEnum(g.inspect(f)["Color"])
+	require.NoError(t, err)
+	assert.True(t, enum.Config.Marshal.GetBool(false))
+	assert.Equal(t, "MyColorRed", enum.Values[0].PrefixedName)
+
+	_, err = g.parseEnum(g.inspect(f)["Status"])
+	assert.EqualError(t, err, "tags.go:6: type Status: unknown annotation: @nocse")
+}
+
+// TestGenerateFromFiles tests that the enums of several files are generated into a single output
+func TestGenerateFromFiles(t *testing.T) {
+	dir := t.TempDir()
+	color := filepath.Join(dir, "color.go")
+	require.NoError(t, os.WriteFile(color, []byte("package test\n\n// @marshal\n// ENUM(red, green)\ntype Color int\n"), 0o644))
+	size := filepath.Join(dir, "size.go")
+	require.NoError(t, os.WriteFile(size, []byte("package test\n\n// @sql\n// ENUM(small, large)\ntype Size string\n"), 0o644))
+
+	g := NewGenerator()
+	output, err := g.GenerateFromFiles(color, size)
+	require.NoError(t, err)
+	assert.Equal(t, 1, strings.Count(string(output), "// Code generated by go-enum DO NOT EDIT."))
+	assert.Equal(t, 1, strings.Count(string(output), "\npackage test\n"))
+	assert.Equal(t, 1, strings.Count(string(output), "\nimport ("))
+	assert.Contains(t, string(output), "ColorRed Color = iota")
+	assert.Contains(t, string(output), "SizeSmall Size = \"small\"")
+	assert.Contains(t, string(output), "func (x *Color) UnmarshalText(")
+	assert.Contains(t, string(output), "func (x *Size) Scan(")
+
+	other := filepath.Join(dir, "other.go")
+	require.NoError(t, os.WriteFile(other, []byte("package other\n\n// ENUM(a, b)\ntype Other int\n"), 0o644))
+	_, err = NewGenerator().GenerateFromFiles(color, other)
+	assert.EqualError(t, err, "generate: input file '"+other+"' is in package other, not test")
+}
+
+// TestStrictAnnotation tests that @strict asserts the interfaces of the enabled options
+func TestStrictAnnotation(t *testing.T) {
+	input := `package test
+	// @strict @marshal @sql
+	// ENUM(red, green)
+	type Color int
+
+	// @strict
+	// ENUM(small, large)
+	type Size string
+	`
+	g := NewGenerator()
+	f, err := parser.ParseFile(g.fileSet, "TestRequiredErrors", input, parser.ParseComments)
+	require.Nil(t, err, "Error parsing no struct input")
+
+	output, err := g.Generate(f)
+	require.Nil(t, err, "Error generating formatted code")
+	assert.Contains(t, string(output), "_ fmt.Stringer             = (*Color)(nil)")
+	assert.Contains(t, string(output), "_ encoding.TextUnmarshaler = (*Color)(nil)")
+	assert.Contains(t, string(output), "_ driver.Valuer            = (*Color)(nil)")
+	assert.Contains(t, string(output), "var (\n\t_ fmt.Stringer = (*Size)(nil)\n)")
+	assert.NotContains(t, string(output), "(*Size)(nil)\n\t_")
+}
+
+// TestJSONStringAnnotation tests that @jsonstring marshals int enums by name and can't be combined with @marshalnumeric
+func TestJSONStringAnnotation(t *testing.T) {
+	input := `package test
+	// @jsonstring
+	// ENUM(one, two)
+	type Number int
+
+	// @jsonstring @marshalnumeric
+	// ENUM(red, green)
+	type Color int
+	`
+	g := NewGenerator()
+	f, err := parser.ParseFile(g.fileSet, "TestRequiredErrors", input, parser.ParseComments)
+	require.Nil(t, err, "Error parsing no struct input")
+
+	output, err := NewGenerator(WithTypes("Number")).Generate(f)
+	require.Nil(t, err, "Error generating formatted code")
+	assert.Contains(t, string(output), "func (x Number) MarshalJSON() ([]byte, error) {\n\treturn json.Marshal(x.String())\n}")
+	assert.Contains(t, string(output), "func (x *Number) UnmarshalJSON(b []byte) error {")
+
+	_, err = g.Generate(f)
+	assert.EqualError(t, err, "enum Color: @jsonstring and @marshalnumeric are incompatible: JSON can't hold both the name and the number")
+}
+
+// TestSizedIntegerTypes tests that values must fit the width of the enum type and that aliases are rejected
+func TestSizedIntegerTypes(t *testing.T) {
+	input := `package test
+	// @bitflag
+	// ENUM(a, b, c, d, e, f, g, h)
+	type Flags uint8
+
+	// ENUM(low=-128, high=127)
+	type Level int8
+
+	// @bitflag
+	// ENUM(a, b, c, d, e, f, g, h, i)
+	type Wide byte
+
+	// ENUM(low=-129)
+	type Under int8
+
+	// ENUM(max=65535, over)
+	type Port uint16
+
+	// ENUM(a, b)
+	type Alias = uint8
+	`
+	g := NewGenerator()
+	f, err := parser.ParseFile(g.fileSet, "TestRequiredErrors", input, parser.ParseComments)
+	require.Nil(t, err, "Error parsing no struct input")
+
+	enums := g.inspect(f)
+	for _, name := range []string{"Flags", "Level"} {
+		_, err = g.parseEnum(enums[name])
+		assert.NoError(t, err, name)
+	}
+
+	_, err = g.parseEnum(enums["Wide"])
+	assert.EqualError(t, err, "enum value 'i' of Wide overflows its byte type with 256")
+	_, err = g.parseEnum(enums["Under"])
+	assert.EqualError(t, err, "enum value 'low' of Under overflows its int8 type with -129")
+	_, err = g.parseEnum(enums["Port"])
+	assert.EqualError(t, err, "enum value 'over' of Port overflows its uint16 type with 65536")
+	_, err = g.parseEnum(enums["Alias"])
+	assert.EqualError(t, err, "enum Alias: ENUM can't be declared on a type alias, use `type Alias uint8` instead")
+}
+
+// TestGroupAnnotation tests that @group puts a shared namespace in front of the type name
+func TestGroupAnnotation(t *testing.T) {
+	input := `package test
+	// @group:"Order"
+	// ENUM(pending, shipped)
+	type Status int
+
+	// @group:"Order"
+	// ENUM(card, cash)
+	type Payment string
+
+	// @group:"Order" @prefix:"My"
+	// ENUM(low, high)
+	type Priority int
+
+	// @group:"Order" @noprefix
+	// ENUM(open, closed)
+	type State int
+	`
+	g := NewGenerator()
+	f, err := parser.ParseFile(g.fileSet, "TestRequiredErrors", input, parser.ParseComments)
+	require.Nil(t, err, "Error parsing no struct input")
+
+	output, err := g.Generate(f)
+	require.Nil(t, err, "Error generating formatted code")
+	assert.Contains(t, string(output), "OrderStatusPending Status = iota")
+	assert.Contains(t, string(output), "OrderStatusShipped\n")
+	assert.Contains(t, string(output), "OrderPaymentCard Payment = \"card\"")
+	assert.Contains(t, string(output), "OrderPaymentCash Payment = \"cash\"")
+	assert.Contains(t, string(output), "MyOrderPriorityLow Priority = iota")
+	assert.Contains(t, string(output), "OrderOpen State = iota")
+
+	input = `package test
+	// @group:"Order" @noprefix
+	// ENUM(open, closed)
+	type State int
+
+	// @group:"Order" @noprefix
+	// ENUM(open, paid)
+	type Payment int
+	`
+	f, err = parser.ParseFile(g.fileSet, "TestRequiredErrors", input, parser.ParseComments)
+	require.Nil(t, err, "Error parsing no struct input")
+	_, err = g.Generate(f)
+	assert.EqualError(t, err, "enum State: constant OrderOpen collides with a value of Payment")
+}
+
+// TestTypesOption tests that WithTypes only generates the named enums
+func TestTypesOption(t *testing.T) {
+	input := `package test
+	// ENUM(red, green)
+	type Color int
+
+	// ENUM(small, large)
+	type Size string
+
+	// ENUM(on, off)
+	type Power int
+	`
+	g := NewGenerator(WithTypes("Size"))
+	f, err := parser.ParseFile(g.fileSet, "TestRequiredErrors", input, parser.ParseComments)
+	require.Nil(t, err, "Error parsing no struct input")
+
+	output, err := g.Generate(f)
+	require.Nil(t, err, "Error generating formatted code")
+	assert.Contains(t, string(output), "SizeSmall Size = \"small\"")
+	assert.NotContains(t, string(output), "Color")
+	assert.NotContains(t, string(output), "Power")
+
+	g = NewGenerator(WithTypes("Color", "Power"))
+	output, err = g.Generate(f)
+	require.Nil(t, err, "Error generating formatted code")
+	assert.Contains(t, string(output), "ColorRed Color = iota")
+	assert.Contains(t, string(output), "PowerOn Power = iota")
+	assert.NotContains(t, string(output), "Size")
+
+	output, err = NewGenerator(WithTypes("Missing")).Generate(f)
+	require.Nil(t, err)
+	assert.Nil(t, output, "nothing is generated when no type matches")
+}
+
+// TestPredicatesAnnotation tests that @predicates adds an Is<Value> method per value
+func TestPredicatesAnnotation(t *testing.T) {
+	input := `package test
+	// @predicates
+	// ENUM(in_progress, _, done)
+	type Status int
+
+	// @predicates
+	// ENUM(valid, invalid)
+	type Check string
+	`
+	g := NewGenerator()
+	f, err := parser.ParseFile(g.fileSet, "TestRequiredErrors", input, parser.ParseComments)
+	require.Nil(t, err, "Error parsing no struct input")
+
+	output, err := NewGenerator(WithTypes("Status")).Generate(f)
+	require.Nil(t, err, "Error generating formatted code")
+	assert.Contains(t, string(output), "func (x Status) IsInProgress() bool {\n\treturn x == StatusInProgress\n}")
+	assert.Contains(t, string(output), "func (x Status) IsDone() bool {")
+	assert.NotContains(t, string(output), "func (x Status) IsX_()")
+
+	_, err = g.Generate(f)
+	assert.EqualError(t, err, "enum Check: the @predicates method of value 'valid' clashes with IsValid()")
+}
+
+func TestParseBytesAnnotation(t *testing.T) {
+	input := `package test
+	// @parsebytes @nocase
+	// ENUM(red, green)
+	type Color int
+
+	// @parsebytes @bitflag
+	// ENUM(read, write)
+	type Perm int
+	`
+	g := NewGenerator()
+	f, err := parser.ParseFile(g.fileSet, "TestRequiredErrors", input, parser.ParseComments)
+	require.Nil(t, err, "Error parsing no struct input")
+
+	output, err := g.Generate(f)
+	require.Nil(t, err, "Error generating formatted code")
+	assert.Contains(t, string(output), "func ParseColorBytes(b []byte) (Color, error) {")
+	assert.Contains(t, string(output), "_ColorLowerValue[string(bytes.ToLower(b))]")
+	assert.Contains(t, string(output), "return ParsePerm(string(b))")
+}
+
+func TestErrFmtAnnotation(t *testing.T) {
+	input := `package test
+	// @errfmt:"%s: invalid %s"
+	// ENUM(red, green)
+	type Color int
+
+	// @errfmt:"%s is 100%% not a %s %d"
+	// ENUM(on, off)
+	type Switch string
+	`
+	g := NewGenerator()
+	f, err := parser.ParseFile(g.fileSet, "TestRequiredErrors", input, parser.ParseComments)
+	require.Nil(t, err, "Error parsing no struct input")
+
+	output, err := NewGenerator(WithTypes("Color")).Generate(f)
+	require.Nil(t, err, "Error generating formatted code")
+	assert.Contains(t, string(output), "return fmt.Sprintf(\"%s: invalid %s\", string(e), \"Color\")")
+	assert.Contains(t, string(output), "return Color(0), _ColorParseError(name)")
+
+	_, err = g.Generate(f)
+	assert.EqualError(t, err, `enum Switch: @errfmt:"%s is 100%% not a %s %d" needs 2 verbs, for the input and the type name, it has 3`)
+}
+
+func TestContainsAnnotation(t *testing.T) {
+	input := `package test
+	// @contains @noparse
+	// ENUM(red, green)
+	type Color int
+	`
+	g := NewGenerator()
+	f, err := parser.ParseFile(g.fileSet, "TestRequiredErrors", input, parser.ParseComments)
+	require.Nil(t, err, "Error parsing no struct input")
+
+	output, err := g.Generate(f)
+	require.Nil(t, err, "Error generating formatted code")
+	assert.Contains(t, string(output), "func ColorContains(x Color) bool {\n\treturn x.IsValid()\n}")
+	assert.Contains(t, string(output), "func ColorContainsString(s string) bool {\n\t_, err := parseColor(s)")
+}
+
+func TestStrictMarshalAnnotation(t *testing.T) {
+	input := `package test
+	// @strictmarshal @marshal @marshalnumeric
+	// ENUM(red, green)
+	type Color int
+	`
+	g := NewGenerator()
+	f, err := parser.ParseFile(g.fileSet, "TestRequiredErrors", input, parser.ParseComments)
+	require.Nil(t, err, "Error parsing no struct input")
+
+	output, err := g.Generate(f)
+	require.Nil(t, err, "Error generating formatted code")
+	check := "if !x.IsValid() {\n\t\treturn nil, fmt.Errorf(\"%d is %w\", x, ErrInvalidColor)\n\t}"
+	assert.Contains(t, string(output), "func (x Color) AppendText(b []byte) ([]byte, error) {\n\t"+check)
+	assert.Contains(t, string(output), "func (x Color) MarshalJSON() ([]byte, error) {\n\t"+check)
+}
+
+func TestJSONV2Annotation(t *testing.T) {
+	input := `package test
+	// @jsonv2 @marshalnumeric
+	// ENUM(red, green)
+	type Color uint8
+
+	// ENUM(on, off)
+	type Switch string
+	`
+	g := NewGenerator(WithBuildTags("example"))
+	f, err := parser.ParseFile(g.fileSet, "TestRequiredErrors", input, parser.ParseComments)
+	require.Nil(t, err, "Error parsing no struct input")
+
+	output, err := g.GenerateOutput(f)
+	require.Nil(t, err, "Error generating formatted code")
+	assert.NotContains(t, string(output.Code), "jsontext")
+
+	v2 := string(output.JSONV2)
+	assert.Contains(t, v2, "//go:build goexperiment.jsonv2 && example\n\npackage test")
+	assert.Contains(t, v2, "func (x Color) MarshalJSONTo(enc *jsontext.Encoder) error {\n\treturn enc.WriteToken(jsontext.Uint(uint64(x)))")
+	assert.Contains(t, v2, "v, err := tok.Uint()")
+	assert.NotContains(t, v2, "case '\"':")
+	assert.NotContains(t, v2, "Switch")
+
+	f, err = parser.ParseFile(g.fileSet, "TestRequiredErrors", "package test\n// ENUM(on, off)\ntype Switch int\n", parser.ParseComments)
+	require.Nil(t, err, "Error parsing no struct input")
+	output, err = g.GenerateOutput(f)
+	require.Nil(t, err, "Error generating formatted code")
+	assert.Nil(t, output.JSONV2)
+
+	// A file without enums doesn't hand back the outputs of the previous one
+	f, err = parser.ParseFile(g.fileSet, "TestRequiredErrors", "package test\n// @jsonv2\n// ENUM(on, off)\ntype Switch int\n", parser.ParseComments)
+	require.NoError(t, err)
+	output, err = g.GenerateOutput(f)
+	require.NoError(t, err)
+	require.NotNil(t, output.JSONV2)
+	f, err = parser.ParseFile(g.fileSet, "TestRequiredErrors", "package test\ntype Plain int\n", parser.ParseComments)
+	require.NoError(t, err)
+	output, err = g.GenerateOutput(f)
+	require.NoError(t, err)
+	assert.Equal(t, &Output{}, output)
+}
+
+func TestNoDocMarker(t *testing.T) {
+	input := `package test
+	// ENUM(pending, syncing [nodoc], done)
+	type Status int
+
+	// ENUM(on, standby [nodoc], off)
+	type Switch string
+	`
+	g := NewGenerator()
+	f, err := parser.ParseFile(g.fileSet, "TestRequiredErrors", input, parser.ParseComments)
+	require.Nil(t, err, "Error parsing no struct input")
+
+	output, err := g.Generate(f)
+	require.Nil(t, err, "Error generating formatted code")
+	assert.Contains(t, string(output), "\t// StatusPending is a Status of type Pending.\n\tStatusPending Status = iota\n\tStatusSyncing\n\t// StatusDone is a Status of type Done.\n")
+	assert.Contains(t, string(output), "\tSwitchOn      Switch = \"on\"\n\tSwitchStandby Switch = \"standby\"\n\t// SwitchOff is a Switch of type off.\n")
+}
+
+func TestSetLookupAnnotation(t *testing.T) {
+	input := `package test
+	// @setlookup @nocase
+	// ENUM(on, _, off)
+	type Switch string
+	`
+	g := NewGenerator()
+	f, err := parser.ParseFile(g.fileSet, "TestRequiredErrors", input, parser.ParseComments)
+	require.Nil(t, err, "Error parsing no struct input")
+
+	output, err := g.Generate(f)
+	require.Nil(t, err, "Error generating formatted code")
+	assert.Contains(t, string(output), "var _SwitchSet = map[Switch]struct{}{\n\tSwitchOn:  {},\n\tSwitchOff: {},\n}")
+	assert.Contains(t, string(output), "func (x Switch) IsValid() bool {\n\t_, ok := _SwitchSet[x]\n\treturn ok\n}")
+}
+
+func TestDuplicateValues(t *testing.T) {
+	input := `package test
+	// ENUM(a, b, a)
+	type Letter int
+
+	// ENUM(one=1, uno=1)
+	type Number int
+
+	// ENUM(on, yes="on")
+	type Switch string
+
+	// ENUM(a, _, _, b)
+	type Skips int
+	`
+	g := NewGenerator()
+	f, err := parser.ParseFile(g.fileSet, "TestRequiredErrors", input, parser.ParseComments)
+	require.Nil(t, err, "Error parsing no struct input")
+	enums := g.inspect(f)
+
+	_, err = g.parseEnum(enums["Letter"])
+	assert.EqualError(t, err, "enum Letter: duplicate value 'a'")
+	_, err = g.parseEnum(enums["Number"])
+	assert.EqualError(t, err, "enum Number: values 'one' and 'uno' both have the number 1")
+	_, err = g.parseEnum(enums["Switch"])
+	assert.EqualError(t, err, `enum Switch: values 'on' and 'yes' both have the string "on"`)
+	_, err = g.parseEnum(enums["Skips"])
+	assert.NoError(t, err)
+
+	output, err := g.Generate(f)
+	assert.EqualError(t, err, "enum Letter: duplicate value 'a'")
+	assert.Empty(t, output)
+}
+
+func TestCommentAnnotation(t *testing.T) {
+	input := `package test
+	// @comment:"Represents order lifecycle states"
+	// ENUM(pending, shipped)
+	type OrderState int
+
+	// @comment:'Says "on" or "off"'
+	// ENUM(on, off)
+	type Switch string
+	`
+	g := NewGenerator(WithPackage("states"))
+	f, err := parser.ParseFile(g.fileSet, "TestRequiredErrors", input, parser.ParseComments)
+	require.Nil(t, err, "Error parsing no struct input")
+
+	output, err := g.Generate(f)
+	require.Nil(t, err, "Error generating formatted code")
+	assert.Contains(t, string(output), "// OrderState is an enumeration of int values.\n// Represents order lifecycle states\ntype OrderState int")
+	assert.Contains(t, string(output), "const OrderStateDoc = \"Represents order lifecycle states\"")
+	assert.Contains(t, string(output), `const SwitchDoc = "Says \"on\" or \"off\""`)
+}
+
+func TestFlagSetAnnotation(t *testing.T) {
+	input := `package test
+	// @bitflag @flagset
+	// ENUM(read, write)
+	type Perm int
+
+	// @flagset
+	// ENUM(on, off)
+	type Switch int
+	`
+	g := NewGenerator()
+	f, err := parser.ParseFile(g.fileSet, "TestRequiredErrors", input, parser.ParseComments)
+	require.Nil(t, err, "Error parsing no struct input")
+
+	output, err := NewGenerator(WithTypes("Perm")).Generate(f)
+	require.Nil(t, err, "Error generating formatted code")
+	assert.Contains(t, string(output), "type PermSet Perm")
+	assert.Contains(t, string(output), "func (s PermSet) ToSlice() []Perm {")
+
+	_, err = g.Generate(f)
+	assert.EqualError(t, err, "enum Switch: @flagset needs a @bitflag enum")
+}
+
+// TestSlogAnnotation tests that @slog generates a LogValue method logging the name
+func TestSlogAnnotation(t *testing.T) {
+	input := `package test
+	// @slog
+	// ENUM(pending, running)
+	type Status int
+
+	// @slog
+	// ENUM(red, green)
+	type Color string
+	`
+	g := NewGenerator()
+	f, err := parser.ParseFile(g.fileSet, "TestRequiredErrors", input, parser.ParseComments)
+	assert.Nil(t, err, "Error parsing no struct input")
+
+	output, err := g.Generate(f)
+	require.NoError(t, err)
+	out := string(output)
+	assert.Contains(t, out, "func (x Status) LogValue() slog.Value {\n\treturn slog.StringValue(x.String())\n}")
+	assert.Contains(t, out, "func (x Color) LogValue() slog.Value {")
+	assert.Contains(t, out, `"log/slog"`)
+}
+
+// TestTrimSpaceAnnotation tests that @trimspace trims the input of Parse, including ParseBytes
+func TestTrimSpaceAnnotation(t *testing.T) {
+	input := `package test
+	// @trimspace @parsebytes
+	// ENUM(pending, running)
+	type Status int
+
+	// @trimspace
+	// ENUM(red, green)
+	type Color string
+
+	// ENUM(up, down)
+	type Direction string
+	`
+	g := NewGenerator()
+	f, err := parser.ParseFile(g.fileSet, "TestRequiredErrors", input, parser.ParseComments)
+	assert.Nil(t, err, "Error parsing no struct input")
+
+	output, err := g.Generate(f)
+	require.NoError(t, err)
+	out := string(output)
+	assert.Contains(t, out, "func ParseStatus(name string) (Status, error) {\n\tname = strings.TrimSpace(name)\n")
+	assert.Contains(t, out, "func ParseStatusBytes(b []byte) (Status, error) {\n\tb = bytes.TrimSpace(b)\n")
+	assert.Contains(t, out, "func ParseColor(name string) (Color, error) {\n\tname = strings.TrimSpace(name)\n")
+	assert.Contains(t, out, "func ParseDirection(name string) (Direction, error) {\n\tif x, ok")
+	// IsValid doesn't trim, a padded value isn't one of the declared ones
+	assert.Contains(t, out, "func (x Color) IsValid() bool {\n\t_, ok := _ColorSet[x]\n\treturn ok\n}")
+	assert.Contains(t, out, "func (x Direction) IsValid() bool {\n\t_, err := ParseDirection(string(x))")
+}
+
+// TestCSVAnnotation tests that @csv generates the gocsv marshaling methods, which need Parse
+func TestCSVAnnotation(t *testing.T) {
+	input := `package test
+	// @csv
+	// ENUM(pending, running)
+	type Status int
+
+	// @csv
+	// ENUM(red, green)
+	type Color string
+	`
+	g := NewGenerator()
+	f, err := parser.ParseFile(g.fileSet, "TestRequiredErrors", input, parser.ParseComments)
+	assert.Nil(t, err, "Error parsing no struct input")
+
+	output, err := g.Generate(f)
+	require.NoError(t, err)
+	out := string(output)
+	for _, name := range []string{"Status", "Color"} {
+		assert.Contains(t, out, "func (x "+name+") MarshalCSV() (string, error) {\n\treturn x.String(), nil\n}")
+		assert.Contains(t, out, "func (x *"+name+") UnmarshalCSV(field string) error {\n\ttmp, err := Parse"+name+"(field)")
+		assert.Contains(t, out, "func Parse"+name+"(name string)")
+	}
+}
+
+// TestTestFileEnums tests that the enums of a test file are generated, leaving out the ones declared inside functions
+func TestTestFileEnums(t *testing.T) {
+	input := `package test
+
+	import "testing"
+
+	// ENUM(pending, running)
+	type Status int
+
+	func TestStatus(t *testing.T) {
+		// ENUM(red, green)
+		type Color string
+
+		_ = func() {
+			// ENUM(up, down)
+			type Direction int
+		}
+	}
+	`
+	fileName := filepath.Join(t.TempDir(), "status_test.go")
+	require.NoError(t, os.WriteFile(fileName, []byte(input), 0o644))
+
+	output, err := NewGenerator().GenerateOutputFromFile(fileName)
+	require.NoError(t, err)
+	out := string(output.Code)
+	assert.Contains(t, out, "func (x Status) String() string")
+	assert.NotContains(t, out, "Color")
+	assert.NotContains(t, out, "Direction")
+	assert.Equal(t, []string{
+		"enum Color: declared inside a function, only package level types can have methods, skipping it",
+		"enum Direction: declared inside a function, only package level types can have methods, skipping it",
+	}, output.Warnings)
+}
+
+// TestScanTypedValue tests that Scan validates the values of its own type before assigning them
+func TestScanTypedValue(t *testing.T) {
+	input := `package test
+	// @sql
+	// ENUM(pending, running)
+	type Status int
+
+	// @sql
+	// ENUM(red, green)
+	type Color string
+	`
+	g := NewGenerator()
+	f, err := parser.ParseFile(g.fileSet, "TestRequiredErrors", input, parser.ParseComments)
+	assert.Nil(t, err, "Error parsing no struct input")
+
+	output, err := g.Generate(f)
+	require.NoError(t, err)
+	out := string(output)
+	assert.Contains(t, out, "\tcase Status:\n\t\t// An already typed value is assigned as is, once it is known to be declared\n\t\tif !v.IsValid() {\n\t\t\treturn fmt.Errorf(\"%d is %w\", v, ErrInvalidStatus)\n\t\t}\n\t\t*x = v\n")
+	assert.Contains(t, out, "\t\tif !v.IsValid() {\n\t\t\treturn fmt.Errorf(\"%s is %w\", string(*v), ErrInvalidColor)\n\t\t}\n\t\t*x = *v\n")
+}
+
+// TestPrefixTemplate tests that @prefix and @suffix expand a template with the type name
+func TestPrefixTemplate(t *testing.T) {
+	input := `package test
+	// @noprefix @prefix:"{{.Type}}V"
+	// ENUM(pending, running)
+	type Status int
+
+	// @noprefix @suffix:"{{ .Type }}"
+	// ENUM(red, green)
+	type Color string
+	`
+	g := NewGenerator()
+	f, err := parser.ParseFile(g.fileSet, "TestRequiredErrors", input, parser.ParseComments)
+	assert.Nil(t, err, "Error parsing no struct input")
+
+	output, err := g.Generate(f)
+	require.NoError(t, err)
+	out := string(output)
+	assert.Contains(t, out, "\tStatusVPending Status = iota\n")
+	assert.Contains(t, out, "\tStatusVRunning\n")
+	assert.Contains(t, out, "\tRedColor Color = \"red\"\n")
+
+	tests := map[string]struct {
+		annotation string
+		err        string
+	}{
+		"unclosed action": {
+			annotation: `@prefix:"{{.Type"`,
+			err:        `enum Status: @prefix:"{{.Type" is not a valid template: template: prefix:1: unclosed action`,
+		},
+		"unknown field": {
+			annotation: `@suffix:"{{.Name}}"`,
+			err:        `enum Status: @suffix:"{{.Name}}" is not a valid template: template: suffix:1:2: executing "suffix" at <.Name>: can't evaluate field Name in type struct { Type string }`,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			input := "package test\n// " + tt.annotation + "\n// ENUM(pending, running)\ntype Status int\n"
+			g := NewGenerator()
+			f, err := parser.ParseFile(g.fileSet, "TestRequiredErrors", input, parser.ParseComments)
+			require.NoError(t, err)
+
+			_, err = g.parseEnum(g.inspect(f)["Status"])
+			assert.EqualError(t, err, tt.err)
+		})
+	}
+}
+
+// TestNormalizedLookupLength tests that inputs longer than any name are not case mapped
+func TestNormalizedLookupLength(t *testing.T) {
+	input := `package test
+	// @nocase @alias:"PENDING_LONGER=pending"
+	// ENUM(pending, running)
+	type Status int
+
+	// @forceupper @parsebytes
+	// ENUM(red, grün)
+	type Color int
+	`
+	g := NewGenerator()
+	f, err := parser.ParseFile(g.fileSet, "TestRequiredErrors", input, parser.ParseComments)
+	assert.Nil(t, err, "Error parsing no struct input")
+
+	output, err := g.Generate(f)
+	require.NoError(t, err)
+	out := string(output)
+	// The alias is the longest name, each rune takes up to utf8.UTFMax bytes
+	assert.Contains(t, out, "\tif len(name) <= 56 {\n\t\tif x, ok := _StatusLowerValue[strings.ToLower(name)]; ok {")
+	assert.Contains(t, out, "\tif len(name) <= 16 {\n\t\tif x, ok := _ColorValue[strings.ToUpper(name)]; ok {")
+	assert.Contains(t, out, "\tif len(b) <= 16 {\n\t\tif x, ok := _ColorValue[string(bytes.ToUpper(b))]; ok {")
+}
+
+// TestValidateOneOf tests that @validate:"oneof" adds the values for a validator tag to Validate
+func TestValidateOneOf(t *testing.T) {
+	input := `package test
+	// @validate:"oneof"
+	// ENUM(pending, _, running=5)
+	type Status int
+
+	// @validate:"oneof"
+	// ENUM(red, dark green = "dark green")
+	type Color string
+	`
+	g := NewGenerator()
+	f, err := parser.ParseFile(g.fileSet, "TestRequiredErrors", input, parser.ParseComments)
+	assert.Nil(t, err, "Error parsing no struct input")
+
+	output, err := g.Generate(f)
+	require.NoError(t, err)
+	out := string(output)
+	assert.Contains(t, out, "func (x Status) Validate() error {")
+	assert.Contains(t, out, "func StatusOneOf() string {\n\treturn \"0 5\"\n}")
+	assert.Contains(t, out, "func ColorOneOf() string {\n\treturn \"red 'dark green'\"\n}")
+
+	input = "package test\n// @validate:\"required\"\n// ENUM(pending, running)\ntype Status int\n"
+	f, err = parser.ParseFile(g.fileSet, "TestRequiredErrors", input, parser.ParseComments)
+	require.NoError(t, err)
+	_, err = g.parseEnum(g.inspect(f)["Status"])
+	assert.EqualError(t, err, `enum Status: @validate:"required" is not supported, use @validate or @validate:"oneof"`)
+}
+
+// TestNegativeValues tests negative and zero values, which unsigned types reject
+func TestNegativeValues(t *testing.T) {
+	input := `package test
+	// ENUM(unknown=-1, ok=0, warn=1)
+	type State int
+
+	// ENUM(unknown=-1, ok=0)
+	type Flags uint8
+	`
+	g := NewGenerator()
+	f, err := parser.ParseFile(g.fileSet, "TestRequiredErrors", input, parser.ParseComments)
+	assert.Nil(t, err, "Error parsing no struct input")
+
+	enum, err := g.parseEnum(g.inspect(f)["State"])
+	require.NoError(t, err)
+	assert.Equal(t, []any{int64(-1), int64(0), int64(1)}, []any{enum.Values[0].ValueInt, enum.Values[1].ValueInt, enum.Values[2].ValueInt})
+
+	output, err := NewGenerator(WithTypes("State")).Generate(f)
+	require.NoError(t, err)
+	assert.Contains(t, string(output), "\tStateUnknown State = iota + -1\n")
+
+	_, err = g.Generate(f)
+	assert.EqualError(t, err, `enum Flags: failed parsing the data part of enum value 'unknown=-1': strconv.ParseUint: parsing "-1": invalid syntax`)
+}
+
+// TestAppendJSONAnnotation tests that @appendjson copies the names only when encoding/json wouldn't escape them
+func TestAppendJSONAnnotation(t *testing.T) {
+	input := `package test
+	// @appendjson
+	// ENUM(pending, running)
+	type Status int
+
+	// @appendjson @marshalnumeric
+	// ENUM(ok=1, fatal=9)
+	type Code uint8
+
+	// @appendjson
+	// ENUM(red, green)
+	type Color string
+
+	// @appendjson
+	// ENUM(a&b = "a&b")
+	type Escaped string
+	`
+	g := NewGenerator()
+	f, err := parser.ParseFile(g.fileSet, "TestRequiredErrors", input, parser.ParseComments)
+	assert.Nil(t, err, "Error parsing no struct input")
+
+	output, err := g.Generate(f)
+	require.NoError(t, err)
+	out := string(output)
+	assert.Contains(t, out, "func (x Status) AppendJSON(b []byte) ([]byte, error) {\n\tb = append(b, '\"')\n\tb = append(b, x.String()...)\n\treturn append(b, '\"'), nil\n}")
+	assert.Contains(t, out, "func (x Code) AppendJSON(b []byte) ([]byte, error) {\n\treturn strconv.AppendUint(b, uint64(x), 10), nil\n}")
+	assert.Contains(t, out, "func (x Color) AppendJSON(b []byte) ([]byte, error) {\n\tif !x.IsValid() {\n\t\tv, err := json.Marshal(string(x))")
+	assert.Contains(t, out, "func (x Escaped) AppendJSON(b []byte) ([]byte, error) {\n\tv, err := json.Marshal(x.String())")
+}
+
+// TestBuildTagsAnnotation tests that @buildtags splits the enums by build constraint
+func TestBuildTagsAnnotation(t *testing.T) {
+	input := `package test
+	// @buildtags:"linux,amd64"
+	// ENUM(x, y)
+	type Arch int
+
+	// ENUM(pending, running)
+	type Status int
+
+	// @buildtags:"integration || e2e"
+	// ENUM(red, green)
+	type Color string
+
+	// @buildtags:"amd64, linux"
+	// ENUM(p, q)
+	type Platform int
+	`
+	g := NewGenerator(WithBuildTags("example"))
+	f, err := parser.ParseFile(g.fileSet, "TestRequiredErrors", input, parser.ParseComments)
+	assert.Nil(t, err, "Error parsing no struct input")
+
+	output, err := g.GenerateOutput(f)
+	require.NoError(t, err)
+	out := string(output.Code)
+	assert.Contains(t, out, "//go:build example\n// +build example\n")
+	assert.Contains(t, out, "func ParseStatus(")
+	assert.NotContains(t, out, "Arch")
+
+	tagged := output.Tagged
+	require.Len(t, tagged, 3)
+	assert.Equal(t, []string{"arch", "color", "platform"}, []string{tagged[0].Name, tagged[1].Name, tagged[2].Name})
+	assert.Contains(t, string(tagged[0].Code), "//go:build example && linux && amd64\n// +build example,linux,amd64\n")
+	assert.Contains(t, string(tagged[0].Code), "func (x Arch) String() string")
+	assert.NotContains(t, string(tagged[0].Code), "Status")
+	assert.Contains(t, string(tagged[1].Code), "//go:build example && (integration || e2e)\n")
+	// Other spellings of a constraint are not merged
+	assert.Contains(t, string(tagged[2].Code), "//go:build example && amd64 && linux\n")
+
+	// Without untagged enums the first constraint goes to the main output
+	input = "package test\n// @buildtags:\"linux\"\n// ENUM(x, y)\ntype Arch int\n"
+	f, err = parser.ParseFile(g.fileSet, "TestRequiredErrors", input, parser.ParseComments)
+	require.NoError(t, err)
+	output, err = g.GenerateOutput(f)
+	require.NoError(t, err)
+	assert.Contains(t, string(output.Code), "//go:build example && linux\n")
+	assert.Empty(t, output.Tagged)
+
+	for annotation, expected := range map[string]string{
+		`@buildtags:"linux,"`:   `enum Arch: @buildtags:"linux," is not a valid build constraint: empty tag`,
+		`@buildtags:"linux &&"`: `enum Arch: @buildtags:"linux &&" is not a valid build constraint: unexpected token )`,
+	} {
+		input = "package test\n// " + annotation + "\n// ENUM(x, y)\ntype Arch int\n"
+		f, err = parser.ParseFile(g.fileSet, "TestRequiredErrors", input, parser.ParseComments)
+		require.NoError(t, err)
+		_, err = g.parseEnum(g.inspect(f)["Arch"])
+		assert.EqualError(t, err, expected)
+	}
+}
+
+// TestUnknownAnnotationContext tests that an unknown annotation reports the type and line it was found on
+func TestUnknownAnnotationContext(t *testing.T) {
+	input := `package test
+
+// Status is fine
+// @marshal
+// ENUM(on, off)
+type Status int
+
+// Color has a typo
+// @marshl
+// ENUM(red, blue)
+type Color int
+
+/*
+Shape has a typo in a block comment
+@sql @nocse
+ENUM(circle, square)
+*/
+type Shape int
+`
+	g := NewGenerator()
+	f, err := parser.ParseFile(g.fileSet, "annotations.go", input, parser.ParseComments)
+	require.NoError(t, err)
+
+	enums := g.inspect(f)
+
+	_, err = g.parseEnum(enums["Status"])
+	assert.NoError(t, err)
+
+	_, err = g.parseEnum(enums["Color"])
+	assert.EqualError(t, err, "annotations.go:9: type Color: unknown annotation: @marshl")
+
+	_, err = g.parseEnum(enums["Shape"])
+	assert.EqualError(t, err, "annotations.go:15: type Shape: unknown annotation: @nocse")
+
+	// The typo fails the run instead of leaving the enum out of the output
+	output, err := g.Generate(f)
+	assert.EqualError(t, err, "annotations.go:9: type Color: unknown annotation: @marshl")
+	assert.Empty(t, output)
+
+	output, err = NewGenerator(WithTypes("Status")).Generate(f)
+	require.NoError(t, err)
+	assert.Contains(t, string(output), "StatusOn Status = iota")
+}
+
+// TestConflictingAnnotations tests that repeating an annotation with a different value is an error
+func TestConflictingAnnotations(t *testing.T) {
+	config := NewEnumConfig()
+	require.NoError(t, config.ParseAnnotation("@marshal"))
+	assert.NoError(t, config.ParseAnnotation("@marshal:true"), "repeating the same value is a no-op")
+	assert.EqualError(t, config.ParseAnnotation("@marshal:false"), "conflicting annotation: @marshal:false was already set to true")
+	assert.True(t, config.Marshal.GetBool(false))
+
+	require.NoError(t, config.ParseAnnotation(`@prefix:"My"`))
+	assert.NoError(t, config.ParseAnnotation(`@prefix="My"`))
+	assert.EqualError(t, config.ParseAnnotation(`@prefix:"Other"`), "conflicting annotation: @prefix:Other was already set to My")
+
+	input := `package test
+
+// @marshal:true @marshal:false
+// ENUM(on, off)
+type Status int
+`
+	g := NewGenerator()
+	f, err := parser.ParseFile(g.fileSet, "conflict.go", input, parser.ParseComments)
+	require.NoError(t, err)
+
+	_, err = g.parseEnum(g.inspect(f)["Status"])
+	assert.EqualError(t, err, "conflict.go:3: type Status: conflicting annotation: @marshal:false was already set to true")
+}
+
+// TestExhaustiveAnnotation tests that @exhaustive adds a case for every value
+func TestExhaustiveAnnotation(t *testing.T) {
+	input := `package test
+	// @exhaustive
+	// ENUM(pending, _, running, done)
+	type Status int
+
+	// @exhaustive @noprefix
+	// ENUM(red, green)
+	type Color string
+	`
+	g := NewGenerator()
+	f, err := parser.ParseFile(g.fileSet, "TestRequiredErrors", input, parser.ParseComments)
+	assert.Nil(t, err, "Error parsing no struct input")
+
+	output, err := g.Generate(f)
+	assert.Nil(t, err, "Error generating formatted code")
+	assert.Contains(t, string(output), "func _StatusExhaustive(x Status) {\n\tswitch x {\n\tcase StatusPending:\n\tcase StatusRunning:\n\tcase StatusDone:\n\t}\n}")
+	assert.Contains(t, string(output), "func _ColorExhaustive(x Color) {\n\tswitch x {\n\tcase Red:\n\tcase Green:\n\t}\n}")
+	if false { // Debugging statement
+		fmt.Println(string(output))
+	}
+}
+
+// TestHeaderAndNoCommentsAnnotations tests that @header adds to the file header and @nocomments only drops the constant docs
+func TestHeaderAndNoCommentsAnnotations(t *testing.T) {
+	input := `package test
+	// @nocomments @header:"Copyright 2024 Example Corp. All rights reserved."
+	// ENUM(pending, running)
+	type Status int
+
+	// @nocomments @header:'Copyright 2024 Example Corp. All rights reserved.'
+	// ENUM(red, green)
+	type Color string
+	`
+	g := NewGenerator(WithNoPrefix())
+	f, err := parser.ParseFile(g.fileSet, "TestRequiredErrors", input, parser.ParseComments)
+	assert.Nil(t, err, "Error parsing no struct input")
+
+	output, err := g.Generate(f)
+	assert.Nil(t, err, "Error generating formatted code")
+	assert.True(t, strings.HasPrefix(string(output), "// Code generated by go-enum DO NOT EDIT.\n"))
+	assert.Equal(t, 1, strings.Count(string(output), "// Copyright 2024 Example Corp. All rights reserved.\n\npackage test\n"))
+	assert.Contains(t, string(output), "const (\n\tPending Status = iota\n\tRunning\n)")
+	assert.Contains(t, string(output), "const (\n\tRed   Color = \"red\"\n\tGreen Color = \"green\"\n)")
+	if false { // Debugging statement
+		fmt.Println(string(output))
+	}
+}
+
+// TestSplitAnnotations tests that quoted annotation values stay in one piece
+func TestSplitAnnotations(t *testing.T) {
+	assert.Equal(t, []string{"@marshal", `@header:"Some text here"`, "@sql"}, splitAnnotations(`@marshal  @header:"Some text here" @sql`))
+	assert.Equal(t, []string{"@prefix:'My Prefix'"}, splitAnnotations(`@prefix:'My Prefix'`))
+	assert.Equal(t, []string{"It's", "an", "@marshal", "enum"}, splitAnnotations(`It's an @marshal enum`))
+}
+
+// TestDefaultValueMarker tests the [default] marker and the @zero fallback to the first value
+func TestDefaultValueMarker(t *testing.T) {
+	input := `package test
+	// ENUM(active, unknown [default], done)
+	type Marked int
+
+	// @zero
+	// ENUM(first, second)
+	type Unmarked string
+
+	// ENUM(a [default], b [default])
+	type Twice int
+	`
+	g := NewGenerator()
+	f, err := parser.ParseFile(g.fileSet, "TestRequiredErrors", input, parser.ParseComments)
+	assert.Nil(t, err, "Error parsing no struct input")
+
+	output, err := NewGenerator(WithTypes("Marked", "Unmarked")).Generate(f)
+	assert.Nil(t, err, "Error generating formatted code")
+	assert.Contains(t, string(output), "const MarkedDefault = MarkedUnknown")
+	assert.NotContains(t, string(output), "if name == \"\" {\n\t\treturn MarkedDefault, nil")
+	assert.Contains(t, string(output), "const UnmarkedDefault = UnmarkedFirst")
+	assert.Contains(t, string(output), "if name == \"\" {\n\t\treturn UnmarkedDefault, nil\n\t}")
+
+	_, err = g.Generate(f)
+	assert.EqualError(t, err, "enum Twice has more than one [default] value")
+	if false { // Debugging statement
+		fmt.Println(string(output))
+	}
+}
@@ -15,6 +15,7 @@ type GeneratorConfig struct {
 	LeaveSnakeCase    bool              `json:"leave_snake_case"`
 	JSONPkg           string            `json:"json_pkg"`
 	Prefix            string            `json:"prefix"`
+	Package           string            `json:"package"`
 	SQLNullInt        bool              `json:"sql_null_int"`
 	SQLNullStr        bool              `json:"sql_null_str"`
 	Ptr               bool              `json:"ptr"`
@@ -23,7 +24,52 @@ type GeneratorConfig struct {
 	ForceUpper        bool              `json:"force_upper"`
 	NoComments        bool              `json:"no_comments"`
 	NoParse           bool              `json:"no_parse"`
+	Yaml              bool              `json:"yaml"`
+	Xml               bool              `json:"xml"`
+	Bitflag           bool              `json:"bitflag"`
+	Toml              bool              `json:"toml"`
+	HideDeprecated    bool              `json:"hide_deprecated"`
+	Proto             bool              `json:"proto"`
+	Iter              bool              `json:"iter"`
+	Ordinal           bool              `json:"ordinal"`
+	MarshalNumeric    bool              `json:"marshal_numeric"`
+	GraphQL           bool              `json:"graphql"`
+	Exhaustive        bool              `json:"exhaustive"`
+	Binary            bool              `json:"binary"`
+	List              bool              `json:"list"`
+	JSONSchema        bool              `json:"jsonschema"`
+	Zero              bool              `json:"zero"`
+	ZeroValid         bool              `json:"zero_valid"`
+	CBOR              bool              `json:"cbor"`
+	Msgpack           bool              `json:"msgpack"`
+	NoString          bool              `json:"no_string"`
+	OrDefault         bool              `json:"or_default"`
+	Descriptions      bool              `json:"descriptions"`
+	LenientJSON       bool              `json:"lenient_json"`
+	GoMap             bool              `json:"go_map"`
+	Validate          bool              `json:"validate"`
+	LazyParse         bool              `json:"lazy_parse"`
+	Format            bool              `json:"format"`
+	Strict            bool              `json:"strict"`
+	JSONString        bool              `json:"json_string"`
+	Batch             bool              `json:"batch"`
+	OmitZero          bool              `json:"omit_zero"`
+	Random            bool              `json:"random"`
+	Predicates        bool              `json:"predicates"`
+	ParseBytes        bool              `json:"parse_bytes"`
+	Contains          bool              `json:"contains"`
+	StrictMarshal     bool              `json:"strict_marshal"`
+	JSONV2            bool              `json:"json_v2"`
+	SetLookup         bool              `json:"set_lookup"`
+	PtrHelper         bool              `json:"ptr_helper"`
+	OpenAPI           bool              `json:"openapi"`
+	FlagSet           bool              `json:"flag_set"`
+	Slog              bool              `json:"slog"`
+	TrimSpace         bool              `json:"trim_space"`
+	CSV               bool              `json:"csv"`
+	AppendJSON        bool              `json:"append_json"`
 	BuildTags         []string          `json:"build_tags"`
+	Types             []string          `json:"types"`
 	ReplacementNames  map[string]string `json:"replacement_names"`
 	TemplateFileNames []string          `json:"template_file_names"`
 }
@@ -63,7 +109,6 @@ func WithLowercaseVariant() Option {
 // WithCaseInsensitiveParse is used to change the enum const values generated to not have the enum on them.
 func WithCaseInsensitiveParse() Option {
 	return func(g *GeneratorConfig) {
-		g.LowercaseLookup = true
 		g.CaseInsensitive = true
 	}
 }
@@ -187,6 +232,13 @@ func WithBuildTags(tags ...string) Option {
 	}
 }
 
+// WithTypes limits the generation to the enums with the given type names.
+func WithTypes(types ...string) Option {
+	return func(g *GeneratorConfig) {
+		g.Types = append(g.Types, types...)
+	}
+}
+
 // WithAliases will set up aliases for the generator.
 func WithAliases(aliases map[string]string) Option {
 	return func(g *GeneratorConfig) {
@@ -212,3 +264,320 @@ func WithNoParse() Option {
 		g.NoParse = true
 	}
 }
+
+// WithYaml is used to add yaml marshalling to the enum
+func WithYaml() Option {
+	return func(g *GeneratorConfig) {
+		g.Yaml = true
+	}
+}
+
+// WithXml is used to add xml marshalling to the enum
+func WithXml() Option {
+	return func(g *GeneratorConfig) {
+		g.Xml = true
+	}
+}
+
+// WithBitflag is used to generate bitmask values and set methods for int enums.
+func WithBitflag() Option {
+	return func(g *GeneratorConfig) {
+		g.Bitflag = true
+	}
+}
+
+// WithToml is used to add toml marshalling to the enum
+func WithToml() Option {
+	return func(g *GeneratorConfig) {
+		g.Toml = true
+	}
+}
+
+// WithHideDeprecated is used to leave values marked as [deprecated] out of the Values list.
+func WithHideDeprecated() Option {
+	return func(g *GeneratorConfig) {
+		g.HideDeprecated = true
+	}
+}
+
+// WithProto adds ToProto and FromProto conversions for int enums.
+func WithProto() Option {
+	return func(g *GeneratorConfig) {
+		g.Proto = true
+	}
+}
+
+// WithIter adds an All() iterator over the enum values.
+func WithIter() Option {
+	return func(g *GeneratorConfig) {
+		g.Iter = true
+	}
+}
+
+// WithOrdinal adds Next, Prev, Index and Compare methods that follow the declaration order of the values.
+// String enums also get an Ordinal method backed by a lookup table.
+func WithOrdinal() Option {
+	return func(g *GeneratorConfig) {
+		g.Ordinal = true
+	}
+}
+
+// WithMarshalNumeric marshals int enums to JSON as their number instead of their name.
+func WithMarshalNumeric() Option {
+	return func(g *GeneratorConfig) {
+		g.MarshalNumeric = true
+	}
+}
+
+// WithGraphQL adds gqlgen MarshalGQL and UnmarshalGQL methods.
+func WithGraphQL() Option {
+	return func(g *GeneratorConfig) {
+		g.GraphQL = true
+	}
+}
+
+// WithExhaustive adds a switch over every value of the enum to guard against removed values.
+func WithExhaustive() Option {
+	return func(g *GeneratorConfig) {
+		g.Exhaustive = true
+	}
+}
+
+// WithBinary adds MarshalBinary and UnmarshalBinary methods.
+func WithBinary() Option {
+	return func(g *GeneratorConfig) {
+		g.Binary = true
+	}
+}
+
+// WithList adds a Parse{{ENUM}}List function for comma separated values.
+func WithList() Option {
+	return func(g *GeneratorConfig) {
+		g.List = true
+	}
+}
+
+// WithJSONSchema adds a {{ENUM}}JSONSchema function describing the allowed values.
+func WithJSONSchema() Option {
+	return func(g *GeneratorConfig) {
+		g.JSONSchema = true
+	}
+}
+
+// WithZero makes empty input and NULL fall back to the default value of the enum.
+func WithZero() Option {
+	return func(g *GeneratorConfig) {
+		g.Zero = true
+	}
+}
+
+// WithZeroValid makes IsValid accept the zero value of the type (0 or an empty string).
+func WithZeroValid() Option {
+	return func(g *GeneratorConfig) {
+		g.ZeroValid = true
+	}
+}
+
+// WithCBOR adds MarshalCBOR and UnmarshalCBOR methods.
+func WithCBOR() Option {
+	return func(g *GeneratorConfig) {
+		g.CBOR = true
+	}
+}
+
+// WithMsgpack adds MarshalMsgpack and UnmarshalMsgpack methods.
+func WithMsgpack() Option {
+	return func(g *GeneratorConfig) {
+		g.Msgpack = true
+	}
+}
+
+// WithNoString is used to leave out the String method so a hand written one can be used.
+func WithNoString() Option {
+	return func(g *GeneratorConfig) {
+		g.NoString = true
+	}
+}
+
+// WithPackage is used to generate the enums in a package of their own.
+// The enum types are declared in the generated file, as methods can only be added to local types.
+func WithPackage(pkg string) Option {
+	return func(g *GeneratorConfig) {
+		g.Package = pkg
+	}
+}
+
+// WithOrDefault adds a Parse{{ENUM}}OrDefault function that falls back to a default value instead of returning an error.
+func WithOrDefault() Option {
+	return func(g *GeneratorConfig) {
+		g.OrDefault = true
+	}
+}
+
+// WithDescriptions adds a Description method returning the inline comment of each value.
+func WithDescriptions() Option {
+	return func(g *GeneratorConfig) {
+		g.Descriptions = true
+	}
+}
+
+// WithLenientJSON makes UnmarshalJSON of int enums accept either the name or the number of a value.
+func WithLenientJSON() Option {
+	return func(g *GeneratorConfig) {
+		g.LenientJSON = true
+	}
+}
+
+// WithGoMap adds {{ENUM}}NameMap and {{ENUM}}ValueMap functions returning copies of the lookup maps.
+func WithGoMap() Option {
+	return func(g *GeneratorConfig) {
+		g.GoMap = true
+	}
+}
+
+// WithValidate adds a Validate method returning the standard error for values that are not valid.
+func WithValidate() Option {
+	return func(g *GeneratorConfig) {
+		g.Validate = true
+	}
+}
+
+// WithLazyParse builds the Parse lookup maps with a sync.Once on first use instead of at package initialization.
+func WithLazyParse() Option {
+	return func(g *GeneratorConfig) {
+		g.LazyParse = true
+	}
+}
+
+// WithFormat adds a Format method implementing fmt.Formatter.
+func WithFormat() Option {
+	return func(g *GeneratorConfig) {
+		g.Format = true
+	}
+}
+
+// WithStrict adds compile time assertions for the interfaces implemented by the enum.
+func WithStrict() Option {
+	return func(g *GeneratorConfig) {
+		g.Strict = true
+	}
+}
+
+// WithJSONString makes int enums marshal to and from their name in JSON.
+func WithJSONString() Option {
+	return func(g *GeneratorConfig) {
+		g.JSONString = true
+	}
+}
+
+// WithBatch adds a Parse{{ENUM}}Batch function parsing a slice of names at once.
+func WithBatch() Option {
+	return func(g *GeneratorConfig) {
+		g.Batch = true
+	}
+}
+
+// WithOmitZero makes the zero or default value of an enum marshal as null in JSON.
+func WithOmitZero() Option {
+	return func(g *GeneratorConfig) {
+		g.OmitZero = true
+	}
+}
+
+// WithRandom adds Random{{ENUM}} helpers returning a random declared value.
+func WithRandom() Option {
+	return func(g *GeneratorConfig) {
+		g.Random = true
+	}
+}
+
+// WithPredicates adds an Is<Value>() method per value.
+func WithPredicates() Option {
+	return func(g *GeneratorConfig) {
+		g.Predicates = true
+	}
+}
+
+// WithParseBytes adds a Parse{{ENUM}}Bytes function parsing a byte slice without converting it to a string.
+func WithParseBytes() Option {
+	return func(g *GeneratorConfig) {
+		g.ParseBytes = true
+	}
+}
+
+// WithContains adds {{ENUM}}Contains and {{ENUM}}ContainsString functions.
+func WithContains() Option {
+	return func(g *GeneratorConfig) {
+		g.Contains = true
+	}
+}
+
+// WithStrictMarshal makes MarshalText and MarshalJSON return an error for undeclared values.
+func WithStrictMarshal() Option {
+	return func(g *GeneratorConfig) {
+		g.StrictMarshal = true
+	}
+}
+
+// WithJSONV2 adds the encoding/json/v2 MarshalJSONTo and UnmarshalJSONFrom methods in a file of their own.
+func WithJSONV2() Option {
+	return func(g *GeneratorConfig) {
+		g.JSONV2 = true
+	}
+}
+
+// WithSetLookup makes IsValid of string enums read a map[{{ENUM}}]struct{} set of the declared values.
+func WithSetLookup() Option {
+	return func(g *GeneratorConfig) {
+		g.SetLookup = true
+	}
+}
+
+// WithPtrHelper adds Ptr() along with a package level {{ENUM}}Ptr(v) function.
+func WithPtrHelper() Option {
+	return func(g *GeneratorConfig) {
+		g.PtrHelper = true
+	}
+}
+
+// WithOpenAPI adds an {{ENUM}}OpenAPISchema function with the enum values and their x-enum-varnames.
+func WithOpenAPI() Option {
+	return func(g *GeneratorConfig) {
+		g.OpenAPI = true
+	}
+}
+
+// WithFlagSet adds a {{ENUM}}Set type managing combinations of the flags of a bitflag enum.
+func WithFlagSet() Option {
+	return func(g *GeneratorConfig) {
+		g.FlagSet = true
+	}
+}
+
+// WithSlog adds a LogValue method so the enum logs as its name with log/slog.
+func WithSlog() Option {
+	return func(g *GeneratorConfig) {
+		g.Slog = true
+	}
+}
+
+// WithTrimSpace trims the leading and trailing whitespace of the input of Parse.
+func WithTrimSpace() Option {
+	return func(g *GeneratorConfig) {
+		g.TrimSpace = true
+	}
+}
+
+// WithCSV adds MarshalCSV and UnmarshalCSV methods, as used by gocarina/gocsv.
+func WithCSV() Option {
+	return func(g *GeneratorConfig) {
+		g.CSV = true
+	}
+}
+
+// WithAppendJSON adds an AppendJSON method appending the JSON of the value to a byte slice.
+func WithAppendJSON() Option {
+	return func(g *GeneratorConfig) {
+		g.AppendJSON = true
+	}
+}
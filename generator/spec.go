@@ -0,0 +1,130 @@
+package generator
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// EnumSpec describes an enum in a spec file, as an alternative to declaring it in Go.
+type EnumSpec struct {
+	Name    string         `yaml:"name" json:"name"`
+	Type    string         `yaml:"type" json:"type"`
+	Values  []string       `yaml:"values" json:"values"`
+	Options map[string]any `yaml:"options" json:"options"`
+}
+
+// Spec is the content of a spec file: the package of the generated code and its enums.
+// Values use the ENUM syntax (`one=1`, `legacy [deprecated]`, ...) and options are
+// the annotations without their @, e.g. `marshal: true` or `prefix: My`.
+type Spec struct {
+	Package string     `yaml:"package" json:"package"`
+	Enums   []EnumSpec `yaml:"enums" json:"enums"`
+}
+
+// GenerateFromSpec generates the enums of a YAML or JSON spec file, declaring their types as well.
+// The spec is turned into the Go declarations it stands for, so it goes through the same
+// annotations and templates as the enums declared in Go.
+func (g *Generator) GenerateFromSpec(specFile string) ([]byte, error) {
+	out, err := g.GenerateOutputFromSpec(specFile)
+	return out.Code, err
+}
+
+// GenerateOutputFromSpec generates the enums of a spec file like GenerateFromSpec, along with the other files
+// they need.
+func (g *Generator) GenerateOutputFromSpec(specFile string) (*Output, error) {
+	f, err := g.parseSpecFile(specFile)
+	if err != nil {
+		return &Output{}, fmt.Errorf("generate: error parsing spec file '%s': %w", specFile, err)
+	}
+	return g.generate(true, f)
+}
+
+// parseSpecFile reads a spec file into the AST of the equivalent Go source.
+func (g *Generator) parseSpecFile(specFile string) (*ast.File, error) {
+	content, err := os.ReadFile(specFile)
+	if err != nil {
+		return nil, err
+	}
+
+	// JSON is valid YAML, so both go through the YAML decoder
+	var spec Spec
+	if err = yaml.Unmarshal(content, &spec); err != nil {
+		return nil, err
+	}
+
+	src, err := spec.source()
+	if err != nil {
+		return nil, err
+	}
+	return parser.ParseFile(g.fileSet, specFile, src, parser.ParseComments)
+}
+
+// source writes the Go declarations of the enums of the spec, with their options as annotations.
+func (s Spec) source() (string, error) {
+	if !token.IsIdentifier(s.Package) {
+		return "", fmt.Errorf("spec package %q is not a valid package name", s.Package)
+	}
+	if len(s.Enums) == 0 {
+		return "", errors.New("spec has no enums")
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "package %s\n", s.Package)
+	for _, enum := range s.Enums {
+		if !token.IsIdentifier(enum.Name) {
+			return "", fmt.Errorf("spec enum name %q is not a valid identifier", enum.Name)
+		}
+		if len(enum.Values) == 0 {
+			return "", fmt.Errorf("spec enum %s has no values", enum.Name)
+		}
+		typ := enum.Type
+		if typ == "" {
+			typ = "int"
+		}
+
+		b.WriteString("\n")
+		if annotations := enum.annotations(); annotations != "" {
+			fmt.Fprintf(&b, "// %s\n", annotations)
+		}
+		b.WriteString("// ENUM(\n")
+		for _, value := range enum.Values {
+			fmt.Fprintf(&b, "// %s\n", value)
+		}
+		fmt.Fprintf(&b, "// )\ntype %s %s\n", enum.Name, typ)
+	}
+	return b.String(), nil
+}
+
+// annotations returns the options of the enum as an annotation line, sorted for a stable output.
+func (e EnumSpec) annotations() string {
+	keys := make([]string, 0, len(e.Options))
+	for key := range e.Options {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	annotations := make([]string, 0, len(keys))
+	for _, key := range keys {
+		switch value := e.Options[key].(type) {
+		case bool:
+			annotations = append(annotations, fmt.Sprintf("@%s:%t", key, value))
+		case string:
+			quote := `"`
+			if strings.Contains(value, `"`) {
+				quote = `'`
+			}
+			annotations = append(annotations, fmt.Sprintf("@%s:%s%s%s", key, quote, value, quote))
+		default:
+			annotations = append(annotations, fmt.Sprintf("@%s:%v", key, value))
+		}
+	}
+	return strings.Join(annotations, " ")
+}
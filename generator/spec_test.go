@@ -0,0 +1,98 @@
+package generator
+
+import (
+	"go/parser"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestGenerateFromSpec makes sure a spec file generates the same code as the enums declared in Go
+func TestGenerateFromSpec(t *testing.T) {
+	annotated := `package source
+
+// @marshal @prefix:"My" @sql:false
+// ENUM(
+// pending
+// running
+// legacy [deprecated]
+// )
+type Status string
+
+// @comment:'Says "yes" or "no"' @values
+// ENUM(
+// no=1
+// yes
+// )
+type Answer uint8
+`
+	yamlSpec := `package: orders
+enums:
+  - name: Status
+    type: string
+    values: [pending, running, "legacy [deprecated]"]
+    options:
+      marshal: true
+      prefix: My
+      sql: false
+  - name: Answer
+    type: uint8
+    values: [no=1, "yes"]
+    options:
+      values: true
+      comment: Says "yes" or "no"
+`
+	jsonSpec := `{
+  "package": "orders",
+  "enums": [
+    {"name": "Status", "type": "string", "values": ["pending", "running", "legacy [deprecated]"], "options": {"marshal": true, "prefix": "My", "sql": false}},
+    {"name": "Answer", "type": "uint8", "values": ["no=1", "yes"], "options": {"values": true, "comment": "Says \"yes\" or \"no\""}}
+  ]
+}`
+
+	g := NewGenerator(WithPackage("orders"))
+	f, err := parser.ParseFile(g.fileSet, "source.go", annotated, parser.ParseComments)
+	require.NoError(t, err)
+	expected, err := g.Generate(f)
+	require.NoError(t, err)
+	assert.Contains(t, string(expected), "type Answer uint8")
+
+	dir := t.TempDir()
+	for name, spec := range map[string]string{"enums.yaml": yamlSpec, "enums.json": jsonSpec} {
+		t.Run(name, func(t *testing.T) {
+			specFile := filepath.Join(dir, name)
+			require.NoError(t, os.WriteFile(specFile, []byte(spec), 0o644))
+
+			output, err := NewGenerator().GenerateFromSpec(specFile)
+			require.NoError(t, err)
+			assert.Equal(t, string(expected), string(output))
+		})
+	}
+}
+
+func TestGenerateFromSpecErrors(t *testing.T) {
+	tests := map[string]struct {
+		spec string
+		err  string
+	}{
+		"no package":   {spec: "enums: [{name: Status, values: [a]}]", err: `spec package "" is not a valid package name`},
+		"no enums":     {spec: "package: orders", err: "spec has no enums"},
+		"bad name":     {spec: "package: orders\nenums: [{name: my-status, values: [a]}]", err: `spec enum name "my-status" is not a valid identifier`},
+		"no values":    {spec: "package: orders\nenums: [{name: Status}]", err: "spec enum Status has no values"},
+		"invalid yaml": {spec: "package: [orders", err: "yaml: line 1: did not find expected ',' or ']'"},
+	}
+
+	dir := t.TempDir()
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			specFile := filepath.Join(dir, "enums.yaml")
+			require.NoError(t, os.WriteFile(specFile, []byte(tt.spec), 0o644))
+
+			_, err := NewGenerator().GenerateFromSpec(specFile)
+			assert.EqualError(t, err, "generate: error parsing spec file '"+specFile+"': "+tt.err)
+		})
+	}
+}
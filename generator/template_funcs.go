@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"unicode/utf8"
 )
 
 // Stringify returns a string that is all of the enum value names concatenated without a separator
@@ -30,7 +31,7 @@ func Mapify(e Enum) (ret string, err error) {
 	index := 0
 	for _, val := range e.Values {
 		if val.Name != skipHolder {
-			nextIndex := index + len(val.Name)
+			nextIndex := index + len(val.RawName)
 			ret = fmt.Sprintf("%s%s: %s[%d:%d],\n", ret, val.PrefixedName, strName, index, nextIndex)
 			index = nextIndex
 		}
@@ -49,7 +50,7 @@ func Unmapify(e Enum, lowercase bool) (ret string, err error) {
 	index := 0
 	for _, val := range e.Values {
 		if val.Name != skipHolder {
-			nextIndex := index + len(val.Name)
+			nextIndex := index + len(val.RawName)
 			ret = fmt.Sprintf("%s%s[%d:%d]: %s,\n", ret, strName, index, nextIndex, val.PrefixedName)
 			if lowercase {
 				ret = fmt.Sprintf("%sstrings.ToLower(%s[%d:%d]): %s,\n", ret, strName, index, nextIndex, val.PrefixedName)
@@ -57,6 +58,12 @@ func Unmapify(e Enum, lowercase bool) (ret string, err error) {
 			index = nextIndex
 		}
 	}
+	for _, alias := range e.Aliases {
+		ret = fmt.Sprintf("%s%q: %s,\n", ret, alias.Alias, alias.Value.PrefixedName)
+		if lowercase && strings.ToLower(alias.Alias) != alias.Alias {
+			ret = fmt.Sprintf("%s%q: %s,\n", ret, strings.ToLower(alias.Alias), alias.Value.PrefixedName)
+		}
+	}
 	ret = ret + `}`
 	return
 }
@@ -82,11 +89,102 @@ func UnmapifyStringEnum(e Enum, lowercase bool) (ret string, err error) {
 			}
 		}
 	}
+	for _, alias := range e.Aliases {
+		_, err = builder.WriteString(fmt.Sprintf("%q:%s,\n", alias.Alias, alias.Value.PrefixedName))
+		if err != nil {
+			return
+		}
+		if lowercase && strings.ToLower(alias.Alias) != alias.Alias {
+			_, err = builder.WriteString(fmt.Sprintf("%q:%s,\n", strings.ToLower(alias.Alias), alias.Value.PrefixedName))
+			if err != nil {
+				return
+			}
+		}
+	}
 	builder.WriteByte('}')
 	ret = builder.String()
 	return
 }
 
+// UnmapifyLower returns a map of the lowercased names for a case insensitive value lookup
+func UnmapifyLower(e Enum) (ret string, err error) {
+	strName := fmt.Sprintf(`_%sName`, e.Name)
+	ret = fmt.Sprintf("map[string]%s{\n", e.Name)
+	index := 0
+	for _, val := range e.Values {
+		if val.Name != skipHolder {
+			if e.Type == "string" {
+				ret = fmt.Sprintf("%s%q: %s,\n", ret, strings.ToLower(val.ValueStr), val.PrefixedName)
+				continue
+			}
+			nextIndex := index + len(val.RawName)
+			ret = fmt.Sprintf("%sstrings.ToLower(%s[%d:%d]): %s,\n", ret, strName, index, nextIndex, val.PrefixedName)
+			index = nextIndex
+		}
+	}
+	for _, alias := range e.Aliases {
+		ret = fmt.Sprintf("%s%q: %s,\n", ret, strings.ToLower(alias.Alias), alias.Value.PrefixedName)
+	}
+	ret = ret + `}`
+	return
+}
+
+// MaxNameBytes returns the longest input, in bytes, that case mapping can turn into a name or alias of the enum.
+// strings.ToLower and ToUpper map rune by rune, keeping the number of runes but not their width.
+func MaxNameBytes(e Enum) int {
+	var runes int
+	for _, val := range e.Values {
+		name := val.RawName
+		if e.Type == "string" {
+			name = val.ValueStr
+		}
+		if val.Name != skipHolder {
+			runes = max(runes, utf8.RuneCountInString(name))
+		}
+	}
+	for _, alias := range e.Aliases {
+		runes = max(runes, utf8.RuneCountInString(alias.Alias))
+	}
+	return runes * utf8.UTFMax
+}
+
+// JSONSafeNames reports whether the names of the enum are written as is in a JSON string, being
+// printable ASCII that encoding/json doesn't escape.
+func JSONSafeNames(e Enum) bool {
+	for _, val := range e.Values {
+		name := val.RawName
+		if e.Type == "string" {
+			name = val.ValueStr
+		}
+		for i := 0; i < len(name); i++ {
+			if c := name[i]; c < 0x20 || c >= 0x7f || strings.IndexByte(`"\<>&`, c) >= 0 {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// OneOf returns the values of the enum separated by spaces, as the `oneof` rule of go-playground/validator
+// expects them: the numbers of an integer enum and the strings of a string enum, quoted when they hold a space.
+func OneOf(e Enum) string {
+	values := make([]string, 0, len(e.Values))
+	for _, val := range e.Values {
+		if val.Name == skipHolder {
+			continue
+		}
+		value := fmt.Sprint(val.ValueInt)
+		if e.Type == "string" {
+			value = val.ValueStr
+		}
+		if strings.Contains(value, " ") {
+			value = "'" + value + "'"
+		}
+		values = append(values, value)
+	}
+	return strings.Join(values, " ")
+}
+
 // Namify returns a slice that is all of the possible names for an enum in a slice
 func Namify(e Enum) (ret string, err error) {
 	if e.Type == "string" {
@@ -97,7 +195,7 @@ func Namify(e Enum) (ret string, err error) {
 	index := 0
 	for _, val := range e.Values {
 		if val.Name != skipHolder {
-			nextIndex := index + len(val.Name)
+			nextIndex := index + len(val.RawName)
 			ret = fmt.Sprintf("%s%s[%d:%d],\n", ret, strName, index, nextIndex)
 			index = nextIndex
 		}
@@ -118,6 +216,17 @@ func namifyStringEnum(e Enum) (ret string, err error) {
 	return
 }
 
+// Ordinals returns the enum values in declaration order, leaving out the skipped ones.
+func Ordinals(e Enum) []EnumValue {
+	values := make([]EnumValue, 0, len(e.Values))
+	for _, val := range e.Values {
+		if val.Name != skipHolder {
+			values = append(values, val)
+		}
+	}
+	return values
+}
+
 func Offset(index int, enumType string, val EnumValue) (strResult string) {
 	if strings.HasPrefix(enumType, "u") {
 		// Unsigned
@@ -1,16 +1,21 @@
 package main
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
+	"io/fs"
 	"log"
 	"os"
 	"path/filepath"
 	"runtime/debug"
+	"slices"
 	"strings"
 	"sync"
 
 	"github.com/abice/go-enum/generator"
 	"github.com/labstack/gommon/color"
+	"github.com/pmezard/go-difflib/difflib"
 	"github.com/urfave/cli/v2"
 )
 
@@ -48,7 +53,56 @@ type rootT struct {
 	ForceUpper        bool
 	NoComments        bool
 	NoParse           bool
+	Yaml              bool
+	Xml               bool
+	Bitflag           bool
+	Toml              bool
+	HideDeprecated    bool
+	Proto             bool
+	Iter              bool
+	Ordinal           bool
+	MarshalNumeric    bool
+	GraphQL           bool
+	Exhaustive        bool
+	Binary            bool
+	List              bool
+	JSONSchema        bool
+	Zero              bool
+	ZeroValid         bool
+	CBOR              bool
+	Msgpack           bool
+	NoString          bool
+	Package           string
+	OrDefault         bool
+	Descriptions      bool
+	LenientJSON       bool
+	GoMap             bool
+	Validate          bool
+	LazyParse         bool
+	Format            bool
+	Strict            bool
+	JSONString        bool
+	Batch             bool
+	OmitZero          bool
+	Random            bool
+	Predicates        bool
+	ParseBytes        bool
+	Contains          bool
+	StrictMarshal     bool
+	JSONV2            bool
+	SetLookup         bool
+	PtrHelper         bool
+	OpenAPI           bool
+	FlagSet           bool
+	Slog              bool
+	TrimSpace         bool
+	CSV               bool
+	AppendJSON        bool
 	OutputSuffix      string
+	Check             bool
+	Single            string
+	IncludeTests      bool
+	Types             cli.StringSlice
 }
 
 func initializeVersion() {
@@ -97,7 +151,7 @@ func main() {
 				Name:        "file",
 				Aliases:     []string{"f"},
 				EnvVars:     []string{"GOFILE"},
-				Usage:       "The file(s) to generate enums.  Use more than one flag for more files.",
+				Usage:       "The file(s) to generate enums.  Use more than one flag for more files. A .yaml, .yml or .json file is read as a spec file declaring the enums.",
 				Required:    true,
 				Destination: &argv.FileNames,
 			},
@@ -113,7 +167,7 @@ func main() {
 			},
 			&cli.BoolFlag{
 				Name:        "nocase",
-				Usage:       "Adds case insensitive parsing to the enumeration (forces lower flag).",
+				Usage:       "Adds case insensitive parsing to the enumeration.",
 				Destination: &argv.NoCase,
 			},
 			&cli.BoolFlag{
@@ -128,7 +182,7 @@ func main() {
 			},
 			&cli.BoolFlag{
 				Name:        "sqlint",
-				Usage:       "Tells the generator that a string typed enum should be stored in sql as an integer value.",
+				Usage:       "Tells the generator that the enum should be stored in sql as an integer value.",
 				Destination: &argv.SQLInt,
 			},
 			&cli.BoolFlag{
@@ -213,6 +267,226 @@ func main() {
 				Usage:       "Prevents generating the Parse method, or generates it as unexported if other methods depend on it.",
 				Destination: &argv.NoParse,
 			},
+			&cli.BoolFlag{
+				Name:        "yaml",
+				Usage:       "Adds yaml marshalling functions.",
+				Destination: &argv.Yaml,
+			},
+			&cli.BoolFlag{
+				Name:        "xml",
+				Usage:       "Adds xml element and attribute marshalling functions.",
+				Destination: &argv.Xml,
+			},
+			&cli.BoolFlag{
+				Name:        "bitflag",
+				Usage:       "Generates int enums as bitmasks (1 << iota) with Has, Add and Remove methods.",
+				Destination: &argv.Bitflag,
+			},
+			&cli.BoolFlag{
+				Name:        "toml",
+				Usage:       "Adds toml marshalling functions.",
+				Destination: &argv.Toml,
+			},
+			&cli.BoolFlag{
+				Name:        "hidedeprecated",
+				Usage:       "Leaves values marked as [deprecated] out of the Values() list.",
+				Destination: &argv.HideDeprecated,
+			},
+			&cli.BoolFlag{
+				Name:        "proto",
+				Usage:       "Adds ToProto and FromProto int32 conversions to int enums.",
+				Destination: &argv.Proto,
+			},
+			&cli.BoolFlag{
+				Name:        "iter",
+				Usage:       "Adds an {{ENUM}}All() iter.Seq over the values in declaration order.",
+				Destination: &argv.Iter,
+			},
+			&cli.BoolFlag{
+				Name:        "ordinal",
+				Usage:       "Adds Next(), Prev(), Index() and Compare() methods that follow the declaration order of the values, string enums also get Ordinal().",
+				Destination: &argv.Ordinal,
+			},
+			&cli.BoolFlag{
+				Name:        "marshalnumeric",
+				Usage:       "Marshals int enums to JSON as their number instead of their name.",
+				Destination: &argv.MarshalNumeric,
+			},
+			&cli.BoolFlag{
+				Name:        "graphql",
+				Usage:       "Adds gqlgen MarshalGQL and UnmarshalGQL methods.",
+				Destination: &argv.GraphQL,
+			},
+			&cli.BoolFlag{
+				Name:        "exhaustive",
+				Usage:       "Adds a _{{ENUM}}Exhaustive switch referencing every value of the enum.",
+				Destination: &argv.Exhaustive,
+			},
+			&cli.BoolFlag{
+				Name:        "binary",
+				Usage:       "Adds MarshalBinary and UnmarshalBinary methods, int enums are encoded as varints.",
+				Destination: &argv.Binary,
+			},
+			&cli.BoolFlag{
+				Name:        "list",
+				Usage:       "Adds a Parse{{ENUM}}List function for comma separated values.",
+				Destination: &argv.List,
+			},
+			&cli.BoolFlag{
+				Name:        "jsonschema",
+				Usage:       "Adds a {{ENUM}}JSONSchema function returning a JSON schema fragment of the allowed values.",
+				Destination: &argv.JSONSchema,
+			},
+			&cli.BoolFlag{
+				Name:        "zero",
+				Usage:       "Makes parsing an empty string and scanning NULL return the [default] value (or the first value).",
+				Destination: &argv.Zero,
+			},
+			&cli.BoolFlag{
+				Name:        "zerovalid",
+				Usage:       "Makes IsValid() accept the zero value of the type (0 or an empty string).",
+				Destination: &argv.ZeroValid,
+			},
+			&cli.BoolFlag{
+				Name:        "cbor",
+				Usage:       "Adds MarshalCBOR and UnmarshalCBOR methods, int enums are encoded as CBOR integers and string enums as CBOR text.",
+				Destination: &argv.CBOR,
+			},
+			&cli.BoolFlag{
+				Name:        "msgpack",
+				Usage:       "Adds MarshalMsgpack and UnmarshalMsgpack methods, int enums are encoded as msgpack integers and string enums as msgpack strings.",
+				Destination: &argv.Msgpack,
+			},
+			&cli.BoolFlag{
+				Name:        "nostring",
+				Usage:       "Leaves out the generated String method, so a hand written one can be used instead.",
+				Destination: &argv.NoString,
+			},
+			&cli.BoolFlag{
+				Name:        "ordefault",
+				Usage:       "Adds a Parse{{ENUM}}OrDefault function that returns a default value when the input is not valid.",
+				Destination: &argv.OrDefault,
+			},
+			&cli.BoolFlag{
+				Name:        "descriptions",
+				Usage:       "Adds a Description() method returning the inline comment given to each value in the ENUM declaration.",
+				Destination: &argv.Descriptions,
+			},
+			&cli.BoolFlag{
+				Name:        "lenientjson",
+				Usage:       "Generates an UnmarshalJSON for int enums that accepts either the name or the number of a value.",
+				Destination: &argv.LenientJSON,
+			},
+			&cli.BoolFlag{
+				Name:        "gomap",
+				Usage:       "Adds {{ENUM}}NameMap() and {{ENUM}}ValueMap() functions returning copies of the name and value maps.",
+				Destination: &argv.GoMap,
+			},
+			&cli.BoolFlag{
+				Name:        "validate",
+				Usage:       "Adds a Validate() error method, returning the standard error when the value is not valid.",
+				Destination: &argv.Validate,
+			},
+			&cli.BoolFlag{
+				Name:        "lazyparse",
+				Usage:       "Builds the Parse lookup maps on first use with a sync.Once, instead of at package initialization.",
+				Destination: &argv.LazyParse,
+			},
+			&cli.BoolFlag{
+				Name:        "format",
+				Usage:       "Adds a Format method implementing fmt.Formatter, so %v and %s print the name and %d the number (or the declaration position of string enums).",
+				Destination: &argv.Format,
+			},
+			&cli.BoolFlag{
+				Name:        "strict",
+				Usage:       "Adds compile time assertions that the enum implements fmt.Stringer and the interfaces of the other enabled options.",
+				Destination: &argv.Strict,
+			},
+			&cli.BoolFlag{
+				Name:        "jsonstring",
+				Usage:       "Generates MarshalJSON/UnmarshalJSON for int enums using the name of the value instead of its number.",
+				Destination: &argv.JSONString,
+			},
+			&cli.BoolFlag{
+				Name:        "batch",
+				Usage:       "Adds a Parse{{ENUM}}Batch function parsing every input of a slice, returning the results and errors in parallel slices.",
+				Destination: &argv.Batch,
+			},
+			&cli.BoolFlag{
+				Name:        "omitzero",
+				Usage:       "Adds IsZero() and a MarshalJSON writing null for the zero value, or the default value when there is one.",
+				Destination: &argv.OmitZero,
+			},
+			&cli.BoolFlag{
+				Name:        "random",
+				Usage:       "Adds Random{{ENUM}}(r *rand.Rand) and Random{{ENUM}}Global() returning a uniformly random declared value, for property tests.",
+				Destination: &argv.Random,
+			},
+			&cli.BoolFlag{
+				Name:        "predicates",
+				Usage:       "Adds an Is<Value>() method per value, checking equality, or the flag with Has for bitflag enums.",
+				Destination: &argv.Predicates,
+			},
+			&cli.BoolFlag{
+				Name:        "parsebytes",
+				Usage:       "Adds a Parse{{ENUM}}Bytes function looking a byte slice up without converting it to a string, so valid input does not allocate.",
+				Destination: &argv.ParseBytes,
+			},
+			&cli.BoolFlag{
+				Name:        "contains",
+				Usage:       "Adds {{ENUM}}Contains(x) and {{ENUM}}ContainsString(s) package functions reporting membership, the function forms of IsValid and Parse for functional pipelines.",
+				Destination: &argv.Contains,
+			},
+			&cli.BoolFlag{
+				Name:        "strictmarshal",
+				Usage:       "Makes MarshalText, AppendText and MarshalJSON return the ErrInvalid{{ENUM}} error for values that are not declared, instead of writing them.",
+				Destination: &argv.StrictMarshal,
+			},
+			&cli.BoolFlag{
+				Name:        "jsonv2",
+				Usage:       "Adds the encoding/json/v2 MarshalJSONTo/UnmarshalJSONFrom methods to a separate _jsonv2 file built with GOEXPERIMENT=jsonv2.",
+				Destination: &argv.JSONV2,
+			},
+			&cli.BoolFlag{
+				Name:        "setlookup",
+				Usage:       "Makes IsValid of string enums check a precomputed map[{{ENUM}}]struct{} set of the declared values instead of calling Parse.",
+				Destination: &argv.SetLookup,
+			},
+			&cli.BoolFlag{
+				Name:        "ptrhelper",
+				Usage:       "Adds the Ptr() method along with a package level {{ENUM}}Ptr(v) function returning a pointer to a copy of v.",
+				Destination: &argv.PtrHelper,
+			},
+			&cli.BoolFlag{
+				Name:        "openapi",
+				Usage:       "Adds an {{ENUM}}OpenAPISchema() function returning an OpenAPI schema fragment with the enum values and their constant names as x-enum-varnames.",
+				Destination: &argv.OpenAPI,
+			},
+			&cli.BoolFlag{
+				Name:        "flagset",
+				Usage:       "Adds a {{ENUM}}Set type to a @bitflag enum, with Add, Remove, Contains, ToSlice and String methods managing combinations of flags.",
+				Destination: &argv.FlagSet,
+			},
+			&cli.BoolFlag{
+				Name:        "slog",
+				Usage:       "Adds a LogValue method returning the name, so the enum implements slog.LogValuer.",
+				Destination: &argv.Slog,
+			},
+			&cli.BoolFlag{
+				Name:        "trimspace",
+				Usage:       "Trims the leading and trailing whitespace of the input of Parse, and of UnmarshalText and UnmarshalJSON going through it.",
+				Destination: &argv.TrimSpace,
+			},
+			&cli.BoolFlag{
+				Name:        "csv",
+				Usage:       "Adds MarshalCSV and UnmarshalCSV methods writing and parsing the name, as used by gocarina/gocsv.",
+				Destination: &argv.CSV,
+			},
+			&cli.BoolFlag{
+				Name:        "appendjson",
+				Usage:       "Adds an AppendJSON(b []byte) method appending the JSON of the value to b, for encoders building JSON by hand.",
+				Destination: &argv.AppendJSON,
+			},
 			&cli.StringSliceFlag{
 				Name:        "buildtag",
 				Aliases:     []string{"b"},
@@ -221,9 +495,35 @@ func main() {
 			},
 			&cli.StringFlag{
 				Name:        "output-suffix",
-				Usage:       "Changes the default filename suffix of _enum to something else.  `.go` will be appended to the end of the string no matter what, so that `_test.go` cases can be accommodated ",
+				Aliases:     []string{"suffix"},
+				Usage:       "Changes the default filename suffix of _enum to something else.  `.go` will be appended to the end of the string no matter what, so that `_test.go` cases can be accommodated. A trailing `.go` is accepted, `--suffix _gen.go` writes file_gen.go",
 				Destination: &argv.OutputSuffix,
 			},
+			&cli.StringFlag{
+				Name:        "package",
+				Usage:       "Writes the generated file to a sub directory with this package name, declaring the enum types in it.",
+				Destination: &argv.Package,
+			},
+			&cli.StringSliceFlag{
+				Name:        "type",
+				Usage:       "Only generates the enums with these type names, as a comma separated list or by repeating the flag.",
+				Destination: &argv.Types,
+			},
+			&cli.StringFlag{
+				Name:        "single",
+				Usage:       "Writes the enums of all the input files, which must share a package, to this single file instead of one file per input.",
+				Destination: &argv.Single,
+			},
+			&cli.BoolFlag{
+				Name:        "includetests",
+				Usage:       "Writes the enums of the _test.go files matched by a --file glob to a _test.go file next to the --single file. Test files named explicitly are always written there.",
+				Destination: &argv.IncludeTests,
+			},
+			&cli.BoolFlag{
+				Name:        "check",
+				Usage:       "Generates in memory and compares with the existing files instead of writing them, failing with a diff when they are out of date.",
+				Destination: &argv.Check,
+			},
 			&cli.BoolFlag{
 				Name:        "no-iota",
 				Usage:       "Disables the use of iota in generated enums.",
@@ -235,64 +535,173 @@ func main() {
 			if argv.NoParse && argv.MustParse {
 				return fmt.Errorf("--noparse and --mustparse are incompatible: MustParse requires the Parse method to exist")
 			}
+			if argv.JSONString && argv.MarshalNumeric {
+				return fmt.Errorf("--jsonstring and --marshalnumeric are incompatible: JSON can't hold both the name and the number")
+			}
 
 			aliases, err := generator.ParseAliases(argv.Aliases.Value())
 			if err != nil {
 				return err
 			}
-			for _, fileOption := range argv.FileNames.Value() {
+			// Build configuration structure
+			jsonPkg := argv.JsonPkg
+			if jsonPkg == "" {
+				jsonPkg = "encoding/json"
+			}
 
-				// Build configuration structure
-				jsonPkg := argv.JsonPkg
-				if jsonPkg == "" {
-					jsonPkg = "encoding/json"
+			var templateFileNames []string
+			if templates := []string(argv.TemplateFileNames.Value()); len(templates) > 0 {
+				for _, t := range templates {
+					if fn, err := globFilenames(t); err != nil {
+						return err
+					} else {
+						templateFileNames = append(templateFileNames, fn...)
+					}
 				}
+			}
+
+			config := generator.GeneratorConfig{
+				NoPrefix:          argv.NoPrefix,
+				NoIota:            argv.NoIota,
+				LowercaseLookup:   argv.Lowercase,
+				CaseInsensitive:   argv.NoCase,
+				Marshal:           argv.Marshal,
+				SQL:               argv.SQL,
+				SQLInt:            argv.SQLInt,
+				Flag:              argv.Flag,
+				Names:             argv.Names,
+				Values:            argv.Values,
+				LeaveSnakeCase:    argv.LeaveSnakeCase,
+				JSONPkg:           jsonPkg,
+				Prefix:            argv.Prefix,
+				Package:           argv.Package,
+				SQLNullInt:        argv.SQLNullInt,
+				SQLNullStr:        argv.SQLNullStr,
+				Ptr:               argv.Ptr,
+				MustParse:         argv.MustParse,
+				ForceLower:        argv.ForceLower,
+				ForceUpper:        argv.ForceUpper,
+				NoComments:        argv.NoComments,
+				NoParse:           argv.NoParse,
+				Yaml:              argv.Yaml,
+				Xml:               argv.Xml,
+				Bitflag:           argv.Bitflag,
+				Toml:              argv.Toml,
+				HideDeprecated:    argv.HideDeprecated,
+				Proto:             argv.Proto,
+				Iter:              argv.Iter,
+				Ordinal:           argv.Ordinal,
+				MarshalNumeric:    argv.MarshalNumeric,
+				GraphQL:           argv.GraphQL,
+				Exhaustive:        argv.Exhaustive,
+				Binary:            argv.Binary,
+				List:              argv.List,
+				JSONSchema:        argv.JSONSchema,
+				Zero:              argv.Zero,
+				ZeroValid:         argv.ZeroValid,
+				CBOR:              argv.CBOR,
+				Msgpack:           argv.Msgpack,
+				NoString:          argv.NoString,
+				OrDefault:         argv.OrDefault,
+				Descriptions:      argv.Descriptions,
+				LenientJSON:       argv.LenientJSON,
+				GoMap:             argv.GoMap,
+				Validate:          argv.Validate,
+				LazyParse:         argv.LazyParse,
+				Format:            argv.Format,
+				Strict:            argv.Strict,
+				JSONString:        argv.JSONString,
+				Batch:             argv.Batch,
+				OmitZero:          argv.OmitZero,
+				Random:            argv.Random,
+				Predicates:        argv.Predicates,
+				ParseBytes:        argv.ParseBytes,
+				Contains:          argv.Contains,
+				StrictMarshal:     argv.StrictMarshal,
+				JSONV2:            argv.JSONV2,
+				SetLookup:         argv.SetLookup,
+				PtrHelper:         argv.PtrHelper,
+				OpenAPI:           argv.OpenAPI,
+				FlagSet:           argv.FlagSet,
+				Slog:              argv.Slog,
+				TrimSpace:         argv.TrimSpace,
+				CSV:               argv.CSV,
+				AppendJSON:        argv.AppendJSON,
+				BuildTags:         argv.BuildTags.Value(),
+				Types:             argv.Types.Value(),
+				ReplacementNames:  aliases,
+				TemplateFileNames: templateFileNames,
+			}
 
-				var templateFileNames []string
-				if templates := []string(argv.TemplateFileNames.Value()); len(templates) > 0 {
-					for _, t := range templates {
-						if fn, err := globFilenames(t); err != nil {
-							return err
-						} else {
-							templateFileNames = append(templateFileNames, fn...)
-						}
+			// Create generator with configuration
+			g := generator.NewGeneratorWithConfig(config)
+			g.Version = version
+			g.Revision = commit
+			g.BuildDate = date
+			g.BuiltBy = builtBy
+
+			outputSuffix := `_enum`
+			if argv.OutputSuffix != "" {
+				outputSuffix = argv.OutputSuffix
+			}
+
+			if argv.Single != "" {
+				var filenames []string
+				for _, fileOption := range argv.FileNames.Value() {
+					fn, err := inputFilenames(fileOption, argv.IncludeTests)
+					if err != nil {
+						return err
 					}
+					filenames = append(filenames, fn...)
+				}
+
+				if i := slices.IndexFunc(filenames, isSpecFile); i >= 0 {
+					return fmt.Errorf("spec file %s can't be written to a single file", filenames[i])
 				}
 
-				config := generator.GeneratorConfig{
-					NoPrefix:          argv.NoPrefix,
-					NoIota:            argv.NoIota,
-					LowercaseLookup:   argv.Lowercase || argv.NoCase,
-					CaseInsensitive:   argv.NoCase,
-					Marshal:           argv.Marshal,
-					SQL:               argv.SQL,
-					SQLInt:            argv.SQLInt,
-					Flag:              argv.Flag,
-					Names:             argv.Names,
-					Values:            argv.Values,
-					LeaveSnakeCase:    argv.LeaveSnakeCase,
-					JSONPkg:           jsonPkg,
-					Prefix:            argv.Prefix,
-					SQLNullInt:        argv.SQLNullInt,
-					SQLNullStr:        argv.SQLNullStr,
-					Ptr:               argv.Ptr,
-					MustParse:         argv.MustParse,
-					ForceLower:        argv.ForceLower,
-					ForceUpper:        argv.ForceUpper,
-					NoComments:        argv.NoComments,
-					NoParse:           argv.NoParse,
-					BuildTags:         argv.BuildTags.Value(),
-					ReplacementNames:  aliases,
-					TemplateFileNames: templateFileNames,
+				outFilePath, _ := filepath.Abs(argv.Single)
+				if argv.Package != "" {
+					outFilePath = filepath.Join(filepath.Dir(outFilePath), argv.Package, filepath.Base(outFilePath))
 				}
 
-				// Create generator with configuration
-				g := generator.NewGeneratorWithConfig(config)
-				g.Version = version
-				g.Revision = commit
-				g.BuildDate = date
-				g.BuiltBy = builtBy
+				// The enums of the test files only exist for the tests, so they go to a _test.go file of their own
+				sources, tests := splitTestFiles(filenames)
+				if strings.HasSuffix(outFilePath, "_test.go") {
+					sources, tests = filenames, nil
+				}
+				for _, single := range []struct {
+					filenames   []string
+					outFilePath string
+					name        string
+				}{
+					{sources, outFilePath, argv.Single},
+					{tests, outputFilePath(outFilePath, "_test"), outputFilePath(argv.Single, "_test")},
+				} {
+					if len(single.filenames) == 0 {
+						continue
+					}
 
+					out("go-enum started. files: %s\n", color.Cyan(strings.Join(single.filenames, ", ")))
+					output, err := g.GenerateOutputFromFiles(single.filenames...)
+					if err != nil {
+						return fmt.Errorf("failed generating enums\nInputFiles=%s\nError=%s", color.Cyan(strings.Join(single.filenames, ", ")), color.RedBg(err))
+					}
+					for _, warning := range output.Warnings {
+						out(color.Yellow("go-enum warning: %s\n"), warning)
+					}
+					if len(output.Code) < 1 {
+						out(color.Yellow("go-enum ignored. file: %s\n"), color.Cyan(single.name))
+						continue
+					}
+					if err = writeOutputs(single.outFilePath, output, argv.Check); err != nil {
+						return err
+					}
+					out("go-enum finished. file: %s\n", color.Cyan(single.name))
+				}
+				return nil
+			}
+
+			for _, fileOption := range argv.FileNames.Value() {
 				var filenames []string
 				if fn, err := globFilenames(fileOption); err != nil {
 					return err
@@ -300,38 +709,38 @@ func main() {
 					filenames = fn
 				}
 
-				outputSuffix := `_enum`
-				if argv.OutputSuffix != "" {
-					outputSuffix = argv.OutputSuffix
-				}
-
 				for _, fileName := range filenames {
 					originalName := fileName
 
 					out("go-enum started. file: %s\n", color.Cyan(originalName))
 					fileName, _ = filepath.Abs(fileName)
 
-					outFilePath := fmt.Sprintf("%s%s.go", strings.TrimSuffix(fileName, filepath.Ext(fileName)), outputSuffix)
-					if strings.HasSuffix(fileName, "_test.go") {
-						outFilePath = strings.Replace(outFilePath, "_test"+outputSuffix+".go", outputSuffix+"_test.go", 1)
+					outFilePath := outputFilePath(fileName, outputSuffix)
+					if argv.Package != "" {
+						outFilePath = filepath.Join(filepath.Dir(outFilePath), argv.Package, filepath.Base(outFilePath))
 					}
 
 					// Parse the file given in arguments
-					raw, err := g.GenerateFromFile(fileName)
+					generateFromFile := g.GenerateOutputFromFile
+					if isSpecFile(fileName) {
+						generateFromFile = g.GenerateOutputFromSpec
+					}
+					output, err := generateFromFile(fileName)
 					if err != nil {
 						return fmt.Errorf("failed generating enums\nInputFile=%s\nError=%s", color.Cyan(fileName), color.RedBg(err))
 					}
+					for _, warning := range output.Warnings {
+						out(color.Yellow("go-enum warning: %s\n"), warning)
+					}
 
 					// Nothing was generated, ignore the output and don't create a file.
-					if len(raw) < 1 {
+					if len(output.Code) < 1 {
 						out(color.Yellow("go-enum ignored. file: %s\n"), color.Cyan(originalName))
 						continue
 					}
 
-					mode := int(0o644)
-					err = os.WriteFile(outFilePath, raw, os.FileMode(mode))
-					if err != nil {
-						return fmt.Errorf("failed writing to file %s: %s", color.Cyan(outFilePath), color.Red(err))
+					if err = writeOutputs(outFilePath, output, argv.Check); err != nil {
+						return err
 					}
 					out("go-enum finished. file: %s\n", color.Cyan(originalName))
 				}
@@ -346,6 +755,174 @@ func main() {
 	}
 }
 
+// outputFilePath returns the path of the file generated for fileName, keeping `_test.go` files as test files.
+// The suffix may end in `.go` or not, `_gen` and `_gen.go` both give file_gen.go.
+func outputFilePath(fileName, suffix string) string {
+	suffix = strings.TrimSuffix(suffix, ".go")
+	outFilePath := fmt.Sprintf("%s%s.go", strings.TrimSuffix(fileName, filepath.Ext(fileName)), suffix)
+	if strings.HasSuffix(fileName, "_test.go") {
+		outFilePath = strings.Replace(outFilePath, "_test"+suffix+".go", suffix+"_test.go", 1)
+	}
+	return outFilePath
+}
+
+// isSpecFile returns whether fileName is a YAML or JSON spec file rather than Go source.
+func isSpecFile(fileName string) bool {
+	switch filepath.Ext(fileName) {
+	case ".yaml", ".yml", ".json":
+		return true
+	}
+	return false
+}
+
+// jsonV2FilePath returns the path of the encoding/json/v2 file generated next to outFilePath.
+func jsonV2FilePath(outFilePath string) string {
+	if base, ok := strings.CutSuffix(outFilePath, "_test.go"); ok {
+		return base + "_jsonv2_test.go"
+	}
+	return strings.TrimSuffix(outFilePath, ".go") + "_jsonv2.go"
+}
+
+// taggedFilePath returns the path of the file generated next to outFilePath for the enums with other
+// @buildtags, told apart by the name of their first enum. The `_tags` ending keeps a name like `windows`
+// from adding the implicit constraint of a `_windows.go` file.
+func taggedFilePath(outFilePath, name string) string {
+	if base, ok := strings.CutSuffix(outFilePath, "_test.go"); ok {
+		return base + "_" + name + "_tags_test.go"
+	}
+	return strings.TrimSuffix(outFilePath, ".go") + "_" + name + "_tags.go"
+}
+
+// writeOutputs writes the generated code to outFilePath, along with the files generated next to it:
+// the encoding/json/v2 methods and the enums with other @buildtags.
+func writeOutputs(outFilePath string, output *generator.Output, check bool) error {
+	if err := writeGenerated(outFilePath, output.Code, check); err != nil {
+		return err
+	}
+	written := map[string]bool{}
+	if output.JSONV2 != nil {
+		if err := writeGenerated(jsonV2FilePath(outFilePath), output.JSONV2, check); err != nil {
+			return err
+		}
+		written[jsonV2FilePath(outFilePath)] = true
+	}
+	for _, tagged := range output.Tagged {
+		taggedPath := taggedFilePath(outFilePath, tagged.Name)
+		if err := writeGenerated(taggedPath, tagged.Code, check); err != nil {
+			return err
+		}
+		written[taggedPath] = true
+		if tagged.JSONV2 != nil {
+			if err := writeGenerated(jsonV2FilePath(taggedPath), tagged.JSONV2, check); err != nil {
+				return err
+			}
+			written[jsonV2FilePath(taggedPath)] = true
+		}
+	}
+	return removeStaleOutputs(outFilePath, written, check)
+}
+
+// generatedHeader starts every file written by go-enum, only those are removed as stale.
+const generatedHeader = "// Code generated by go-enum DO NOT EDIT."
+
+// removeStaleOutputs deletes the files generated next to outFilePath that weren't written this time: the
+// @buildtags files of enums that moved to another file or lost their tags and would be declared twice, and
+// the encoding/json/v2 methods of enums that lost @jsonv2. In check mode they are reported instead.
+func removeStaleOutputs(outFilePath string, written map[string]bool, check bool) error {
+	pattern := taggedFilePath(outFilePath, "*")
+	for _, glob := range []string{jsonV2FilePath(outFilePath), pattern, jsonV2FilePath(pattern)} {
+		matches, err := filepath.Glob(glob)
+		if err != nil {
+			return fmt.Errorf("failed listing the files generated next to %s: %s", color.Cyan(outFilePath), color.Red(err))
+		}
+		for _, match := range matches {
+			if written[match] {
+				continue
+			}
+			content, err := os.ReadFile(match)
+			if err != nil {
+				return fmt.Errorf("failed reading file %s: %s", color.Cyan(match), color.Red(err))
+			}
+			if !bytes.HasPrefix(content, []byte(generatedHeader)) {
+				continue
+			}
+			if check {
+				return fmt.Errorf("generated file %s is stale, no enum of %s needs it anymore", color.Cyan(match), color.Cyan(outFilePath))
+			}
+			if err := os.Remove(match); err != nil {
+				return fmt.Errorf("failed removing stale file %s: %s", color.Cyan(match), color.Red(err))
+			}
+		}
+	}
+	return nil
+}
+
+// writeGenerated writes the generated code to outFilePath, creating its directory if needed.
+// In check mode the file is only compared with the generated code.
+func writeGenerated(outFilePath string, raw []byte, check bool) error {
+	if check {
+		return checkGenerated(outFilePath, raw)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(outFilePath), 0o755); err != nil {
+		return fmt.Errorf("failed creating directory for %s: %s", color.Cyan(outFilePath), color.Red(err))
+	}
+
+	mode := int(0o644)
+	if err := os.WriteFile(outFilePath, raw, os.FileMode(mode)); err != nil {
+		return fmt.Errorf("failed writing to file %s: %s", color.Cyan(outFilePath), color.Red(err))
+	}
+	return nil
+}
+
+// checkGenerated compares the generated code with the existing file at outFilePath,
+// returning an error holding a unified diff when the file is missing or out of date.
+func checkGenerated(outFilePath string, raw []byte) error {
+	existing, err := os.ReadFile(outFilePath)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("failed reading file %s: %s", color.Cyan(outFilePath), color.Red(err))
+	}
+	if bytes.Equal(existing, raw) {
+		return nil
+	}
+
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(string(existing)),
+		B:        difflib.SplitLines(string(raw)),
+		FromFile: outFilePath,
+		ToFile:   outFilePath + " (generated)",
+		Context:  3,
+	})
+	if err != nil {
+		return fmt.Errorf("failed diffing file %s: %s", color.Cyan(outFilePath), color.Red(err))
+	}
+	return fmt.Errorf("generated file %s is out of date:\n%s", color.Cyan(outFilePath), diff)
+}
+
+// inputFilenames expands a --file option into the files to write to a --single file, leaving out the
+// _test.go files matched by a glob unless includeTests is set.
+func inputFilenames(fileOption string, includeTests bool) ([]string, error) {
+	filenames, err := globFilenames(fileOption)
+	if err != nil || includeTests || !strings.Contains(fileOption, "*") {
+		return filenames, err
+	}
+	return slices.DeleteFunc(filenames, func(fileName string) bool {
+		return strings.HasSuffix(fileName, "_test.go")
+	}), nil
+}
+
+// splitTestFiles separates the _test.go files from the other filenames.
+func splitTestFiles(filenames []string) (sources, tests []string) {
+	for _, fileName := range filenames {
+		if strings.HasSuffix(fileName, "_test.go") {
+			tests = append(tests, fileName)
+		} else {
+			sources = append(sources, fileName)
+		}
+	}
+	return sources, tests
+}
+
 // globFilenames gets a list of filenames matching the provided filename.
 // In order to maintain existing capabilities, only glob when a * is in the path.
 // Leave execution on par with old method in case there are bad patterns in use that somehow
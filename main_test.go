@@ -649,22 +649,151 @@ func TestOutputFilenameGeneration(t *testing.T) {
 			outputSuffix: "_custom",
 			expected:     "/path/to/file_custom_test.go",
 		},
+		{
+			name:         "regular go file with suffix ending in .go",
+			inputFile:    "/path/to/file.go",
+			outputSuffix: "_gen.go",
+			expected:     "/path/to/file_gen.go",
+		},
+		{
+			name:         "test go file with suffix ending in .go",
+			inputFile:    "/path/to/file_test.go",
+			outputSuffix: "_gen.go",
+			expected:     "/path/to/file_gen_test.go",
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			// Simulate the filename generation logic from main
-			fileName := tt.inputFile
-			outputSuffix := tt.outputSuffix
+			assert.Equal(t, tt.expected, outputFilePath(tt.inputFile, tt.outputSuffix))
+		})
+	}
+}
 
-			outFilePath := fmt.Sprintf("%s%s.go", strings.TrimSuffix(fileName, filepath.Ext(fileName)), outputSuffix)
-			if strings.HasSuffix(fileName, "_test.go") {
-				outFilePath = strings.Replace(outFilePath, "_test"+outputSuffix+".go", outputSuffix+"_test.go", 1)
-			}
+func TestIsSpecFile(t *testing.T) {
+	assert.True(t, isSpecFile("enums.yaml"))
+	assert.True(t, isSpecFile("/path/to/enums.yml"))
+	assert.True(t, isSpecFile("enums.json"))
+	assert.False(t, isSpecFile("enums.go"))
+	assert.False(t, isSpecFile("yaml.go"))
+}
 
-			assert.Equal(t, tt.expected, outFilePath)
-		})
+func TestInputFilenames(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"status.go", "status_test.go"} {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte("package main"), 0o644))
 	}
+	glob := filepath.Join(dir, "*.go")
+
+	filenames, err := inputFilenames(glob, false)
+	require.NoError(t, err)
+	assert.Equal(t, []string{filepath.Join(dir, "status.go")}, filenames)
+
+	filenames, err = inputFilenames(glob, true)
+	require.NoError(t, err)
+	assert.Equal(t, []string{filepath.Join(dir, "status.go"), filepath.Join(dir, "status_test.go")}, filenames)
+
+	// A test file named explicitly, as go:generate does with $GOFILE, is always used
+	filenames, err = inputFilenames(filepath.Join(dir, "status_test.go"), false)
+	require.NoError(t, err)
+	assert.Equal(t, []string{filepath.Join(dir, "status_test.go")}, filenames)
+
+	filenames, err = inputFilenames(glob, true)
+	require.NoError(t, err)
+	sources, tests := splitTestFiles(filenames)
+	assert.Equal(t, []string{filepath.Join(dir, "status.go")}, sources)
+	assert.Equal(t, []string{filepath.Join(dir, "status_test.go")}, tests)
+}
+
+func TestJSONV2FilePath(t *testing.T) {
+	assert.Equal(t, "/path/to/file_enum_jsonv2.go", jsonV2FilePath("/path/to/file_enum.go"))
+	assert.Equal(t, "/path/to/file_enum_jsonv2_test.go", jsonV2FilePath("/path/to/file_enum_test.go"))
+}
+
+func TestTaggedFilePath(t *testing.T) {
+	assert.Equal(t, "/path/to/file_enum_windows_tags.go", taggedFilePath("/path/to/file_enum.go", "windows"))
+	assert.Equal(t, "/path/to/file_enum_arch_tags_test.go", taggedFilePath("/path/to/file_enum_test.go", "arch"))
+}
+
+func TestWriteOutputsBuildTags(t *testing.T) {
+	dir := t.TempDir()
+	source := filepath.Join(dir, "enums.go")
+	require.NoError(t, os.WriteFile(source, []byte(`package enums
+
+// ENUM(a, b)
+type Plain int
+
+// @buildtags:"linux,amd64"
+// ENUM(x, y)
+type Arch int
+`), 0o644))
+
+	g := generator.NewGenerator(generator.WithBuildTags("example"))
+	output, err := g.GenerateOutputFromFile(source)
+	require.NoError(t, err)
+	outFilePath := outputFilePath(source, "_enum")
+	require.NoError(t, writeOutputs(outFilePath, output, false))
+
+	plain, err := os.ReadFile(outFilePath)
+	require.NoError(t, err)
+	assert.Contains(t, string(plain), "//go:build example\n")
+	assert.NotContains(t, string(plain), "Arch")
+
+	arch, err := os.ReadFile(filepath.Join(dir, "enums_enum_arch_tags.go"))
+	require.NoError(t, err)
+	assert.Contains(t, string(arch), "//go:build example && linux && amd64\n// +build example,linux,amd64\n")
+	assert.Contains(t, string(arch), "func (x Arch) String() string")
+
+	// The files are up to date
+	require.NoError(t, writeOutputs(outFilePath, output, true))
+
+	// Without its @buildtags, Arch goes back to the main file and the stale file is removed
+	own := filepath.Join(dir, "enums_enum_own_tags.go")
+	require.NoError(t, os.WriteFile(own, []byte("package enums\n"), 0o644))
+	require.NoError(t, os.WriteFile(source, []byte("package enums\n\n// ENUM(a, b)\ntype Plain int\n\n// ENUM(x, y)\ntype Arch int\n"), 0o644))
+	output, err = g.GenerateOutputFromFile(source)
+	require.NoError(t, err)
+	err = writeOutputs(outFilePath, output, true)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "is out of date")
+	require.NoError(t, writeGenerated(outFilePath, output.Code, false))
+	err = writeOutputs(outFilePath, output, true)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "enums_enum_arch_tags.go is stale")
+
+	require.NoError(t, writeOutputs(outFilePath, output, false))
+	assert.NoFileExists(t, filepath.Join(dir, "enums_enum_arch_tags.go"))
+	// Files go-enum didn't generate are left alone
+	assert.FileExists(t, own)
+	require.NoError(t, writeOutputs(outFilePath, output, true))
+}
+
+func TestWriteOutputsJSONV2(t *testing.T) {
+	dir := t.TempDir()
+	source := filepath.Join(dir, "enums.go")
+	require.NoError(t, os.WriteFile(source, []byte("package enums\n\n// @jsonv2\n// ENUM(a, b)\ntype Mode int\n"), 0o644))
+
+	g := generator.NewGenerator()
+	output, err := g.GenerateOutputFromFile(source)
+	require.NoError(t, err)
+	outFilePath := outputFilePath(source, "_enum")
+	require.NoError(t, writeOutputs(outFilePath, output, false))
+	assert.FileExists(t, jsonV2FilePath(outFilePath))
+	require.NoError(t, writeOutputs(outFilePath, output, true))
+
+	// Without @jsonv2 the methods it generated would be left behind, declared for an enum without them
+	require.NoError(t, os.WriteFile(source, []byte("package enums\n\n// ENUM(a, b)\ntype Mode int\n"), 0o644))
+	output, err = g.GenerateOutputFromFile(source)
+	require.NoError(t, err)
+	require.Nil(t, output.JSONV2)
+	require.NoError(t, writeGenerated(outFilePath, output.Code, false))
+	err = writeOutputs(outFilePath, output, true)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "enums_enum_jsonv2.go is stale")
+
+	require.NoError(t, writeOutputs(outFilePath, output, false))
+	assert.NoFileExists(t, jsonV2FilePath(outFilePath))
+	require.NoError(t, writeOutputs(outFilePath, output, true))
 }
 
 func TestCliFlagAliases(t *testing.T) {
@@ -2368,3 +2497,35 @@ func createTestFlags() []cli.Flag {
 		},
 	}
 }
+
+// TestCheckGenerated tests that check mode accepts an up to date file and reports a diff for a changed one
+func TestCheckGenerated(t *testing.T) {
+	tmpDir := t.TempDir()
+	inputFile := filepath.Join(tmpDir, "color.go")
+	require.NoError(t, os.WriteFile(inputFile, []byte(`package main
+// ENUM(Red, Green)
+type Color int
+`), 0o644))
+
+	raw, err := generator.NewGenerator().GenerateFromFile(inputFile)
+	require.NoError(t, err)
+	outFile := filepath.Join(tmpDir, "color_enum.go")
+
+	err = checkGenerated(outFile, raw)
+	require.Error(t, err, "a missing file is out of date")
+	assert.Contains(t, err.Error(), "is out of date")
+
+	require.NoError(t, os.WriteFile(outFile, raw, 0o644))
+	assert.NoError(t, checkGenerated(outFile, raw))
+
+	changed := strings.Replace(string(raw), "ColorGreen", "ColorBlue", 1)
+	require.NoError(t, os.WriteFile(outFile, []byte(changed), 0o644))
+	err = checkGenerated(outFile, raw)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "-\t// ColorBlue is a Color of type Green.")
+	assert.Contains(t, err.Error(), "+\t// ColorGreen is a Color of type Green.")
+
+	content, err := os.ReadFile(outFile)
+	require.NoError(t, err)
+	assert.Equal(t, changed, string(content), "check mode never writes the file")
+}